@@ -0,0 +1,241 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+)
+
+// DefaultAlternatesLimit is the number of alternates FindAlternates returns
+// when AlternateCriteria.Limit is zero.
+const DefaultAlternatesLimit = 10
+
+// AlternateSource is how an alternate was found.
+type AlternateSource int
+
+// Alternate sources.
+const (
+	// AlternateSubstitution is a substitute suggested by DigiKey.
+	AlternateSubstitution AlternateSource = iota
+	// AlternateParametric is a product in the same category sharing the
+	// part's key parameter values.
+	AlternateParametric
+)
+
+// String implements fmt.Stringer.
+func (s AlternateSource) String() string {
+	if s == AlternateParametric {
+		return "Parametric"
+	}
+	return "Substitution"
+}
+
+// AlternateCriteria constrains the alternates FindAlternates proposes.
+type AlternateCriteria struct {
+	// MatchParameters are the IDs of parameters whose values an alternate
+	// must share with the part, such as capacitance and voltage rating.
+	// They also narrow the parametric search. If empty, alternates are
+	// ranked on all of the part's parameters but none are required.
+	MatchParameters []int
+	// MinQuantityAvailable excludes alternates with less stock.
+	MinQuantityAvailable int
+	// ExcludeRisky excludes alternates whose lifecycle is risky.
+	ExcludeRisky bool
+	// RequireRoHS excludes alternates that are not RoHS compliant.
+	RequireRoHS bool
+	// Limit is the maximum number of alternates returned, or
+	// DefaultAlternatesLimit if zero.
+	Limit int
+}
+
+// Alternate is a product proposed in place of another.
+type Alternate struct {
+	Product Product
+	Source  AlternateSource
+	// SubstituteType is DigiKey's description of a substitution, such as
+	// "Direct" or "Similar", and is empty for parametric matches.
+	SubstituteType string
+	// MatchedParameters is the number of the part's parameters the
+	// alternate shares, out of TotalParameters.
+	MatchedParameters int
+	TotalParameters   int
+	// Score ranks the alternate from 0 to 1, higher being a better drop-in
+	// replacement.
+	Score float64
+}
+
+// FindAlternates proposes drop-in alternates for the product with the given
+// DigiKey or manufacturer product number, combining DigiKey's suggested
+// substitutes with a parametric search of the product's category. The
+// alternates are ranked by how closely their parameters match, how DigiKey
+// classifies the substitution, and their availability, with ties going to
+// the lower unit price.
+func (s *ProductsService) FindAlternates(ctx context.Context, partNumber string, criteria AlternateCriteria, opts ...RequestOption) ([]Alternate, error) {
+	part, err := s.ProductDetails(ctx, partNumber, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting product details: %w", err)
+	}
+
+	candidates := make(map[string]Alternate)
+	subs, err := s.Substitutions(ctx, partNumber, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting substitutions: %w", err)
+	}
+	for _, sub := range subs {
+		pn := sub.DigiKeyProductNumber
+		if pn == "" {
+			pn = sub.ManufacturerProductNumber
+		}
+		p, err := s.ProductDetails(ctx, pn, opts...)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			continue
+		}
+		candidates[p.ManufacturerProductNumber] = Alternate{
+			Product:        *p,
+			Source:         AlternateSubstitution,
+			SubstituteType: sub.SubstituteType,
+		}
+	}
+
+	resp, err := s.KeywordSearch(ctx, parametricRequest(part, criteria), opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error searching for parametric matches: %w", err)
+	}
+	for _, p := range resp.Products {
+		if _, ok := candidates[p.ManufacturerProductNumber]; !ok {
+			candidates[p.ManufacturerProductNumber] = Alternate{Product: p, Source: AlternateParametric}
+		}
+	}
+	delete(candidates, part.ManufacturerProductNumber)
+
+	alternates := make([]Alternate, 0, len(candidates))
+	for _, a := range candidates {
+		if !criteria.accepts(part, a.Product) {
+			continue
+		}
+		a.MatchedParameters, a.TotalParameters = matchParameters(part.Parameters, a.Product.Parameters)
+		a.Score = a.score(criteria)
+		alternates = append(alternates, a)
+	}
+	slices.SortFunc(alternates, func(a, b Alternate) int {
+		if c := cmp.Compare(b.Score, a.Score); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Product.UnitPrice.Amount, b.Product.UnitPrice.Amount)
+	})
+	limit := criteria.Limit
+	if limit <= 0 {
+		limit = DefaultAlternatesLimit
+	}
+	return alternates[:min(limit, len(alternates))], nil
+}
+
+// parametricRequest returns a search for products in the part's category
+// sharing the values of the criteria's parameters.
+func parametricRequest(part *Product, criteria AlternateCriteria) KeywordRequest {
+	r := NewSearchRequest("").Limit(MaxSearchLimit).Sort(ByQuantityAvailableDesc)
+	if part.Category.CategoryID != 0 {
+		r.Category(part.Category.CategoryID)
+	}
+	if criteria.MinQuantityAvailable > 0 {
+		r.InStockOnly()
+	}
+	for _, id := range criteria.MatchParameters {
+		for _, p := range part.Parameters {
+			if p.ParameterID == id && p.ValueID != "" {
+				r.Parameter(part.Category.CategoryID, id, p.ValueID)
+			}
+		}
+	}
+	return r.Build()
+}
+
+// accepts reports whether the candidate meets the criteria.
+func (c AlternateCriteria) accepts(part *Product, candidate Product) bool {
+	if candidate.QuantityAvailable < c.MinQuantityAvailable {
+		return false
+	}
+	if c.ExcludeRisky && candidate.Lifecycle().Risky() {
+		return false
+	}
+	if c.RequireRoHS && !candidate.IsRoHSCompliant() {
+		return false
+	}
+	for _, id := range c.MatchParameters {
+		if parameterValue(part.Parameters, id) != parameterValue(candidate.Parameters, id) {
+			return false
+		}
+	}
+	return true
+}
+
+// score ranks the alternate, weighting parameter matches most heavily.
+func (a Alternate) score(c AlternateCriteria) float64 {
+	params := 0.0
+	if a.TotalParameters > 0 {
+		params = float64(a.MatchedParameters) / float64(a.TotalParameters)
+	}
+	source := 0.0
+	switch a.SubstituteType {
+	case "Direct":
+		source = 1
+	case "Parametric Equivalent":
+		source = 0.8
+	case "":
+	default:
+		source = 0.5
+	}
+	stock := 0.0
+	if a.Product.QuantityAvailable > 0 {
+		stock = 0.5
+		if a.Product.QuantityAvailable >= max(c.MinQuantityAvailable, 1) && !a.Product.Lifecycle().Risky() {
+			stock = 1
+		}
+	}
+	return 0.5*params + 0.3*source + 0.2*stock
+}
+
+// matchParameters returns the number of the part's parameters whose values
+// the candidate shares, and the number of the part's parameters.
+func matchParameters(part, candidate []Parameter) (matched, total int) {
+	for _, p := range part {
+		if p.ValueText == "" || p.ValueText == "-" {
+			continue
+		}
+		total++
+		if parameterValue(candidate, p.ParameterID) == parameterKey(p) {
+			matched++
+		}
+	}
+	return matched, total
+}
+
+// parameterValue returns the value of the parameter with the given ID, or
+// the empty string if there is none.
+func parameterValue(params []Parameter, id int) string {
+	for _, p := range params {
+		if p.ParameterID == id {
+			return parameterKey(p)
+		}
+	}
+	return ""
+}
+
+// parameterKey returns the comparable value of a parameter, preferring its
+// value ID over its display text.
+func parameterKey(p Parameter) string {
+	if p.ValueID != "" {
+		return "id:" + p.ValueID
+	}
+	return "text:" + strconv.Quote(p.ValueText)
+}
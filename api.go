@@ -0,0 +1,93 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import (
+	"context"
+	"io"
+	"iter"
+	"time"
+)
+
+// The interfaces below are implemented by the services of a Client. Code
+// that depends on them rather than on the services can be unit tested with
+// the mocks in the digikeymock package. Run "just mocks" after changing
+// them.
+
+// ProductsAPI is the interface of ProductsService.
+type ProductsAPI interface {
+	KeywordSearch(ctx context.Context, req KeywordRequest, opts ...RequestOption) (*KeywordResponse, error)
+	KeywordSearchAll(ctx context.Context, req KeywordRequest, opts ...RequestOption) iter.Seq2[Product, error]
+	KeywordSearchStream(ctx context.Context, req KeywordRequest, fn func(Product) error, opts ...RequestOption) (*KeywordResponse, error)
+	Count(ctx context.Context, req KeywordRequest, opts ...RequestOption) (int, error)
+	Resolve(ctx context.Context, req KeywordRequest, opts ...RequestOption) (KeywordRequest, error)
+	SearchMany(ctx context.Context, keywords []string, opts ...RequestOption) map[string]SearchResult
+	ProductDetails(ctx context.Context, partNumber string, opts ...RequestOption) (*Product, error)
+	KeywordSearchV3(ctx context.Context, req KeywordSearchRequestV3, opts ...RequestOption) (*KeywordSearchResponseV3, error)
+	ProductDetailsV3(ctx context.Context, partNumber string, opts ...RequestOption) (*ProductV3, error)
+	ByManufacturerPartNumber(ctx context.Context, mpn string, opts ...RequestOption) ([]PartMatch, error)
+	ExactMPN(ctx context.Context, mpn string, opts ...RequestOption) (*ExactMPNResult, error)
+	Pricing(ctx context.Context, partNumber string, opts ...RequestOption) ([]ProductPricing, error)
+	Media(ctx context.Context, partNumber string, opts ...RequestOption) (*Media, error)
+	DatasheetURL(ctx context.Context, partNumber string, opts ...RequestOption) (string, error)
+	DownloadDatasheet(ctx context.Context, partNumber string, w io.Writer, opts ...RequestOption) (int64, error)
+	Substitutions(ctx context.Context, partNumber string, opts ...RequestOption) ([]ProductSubstitute, error)
+	Associations(ctx context.Context, partNumber string, opts ...RequestOption) (*ProductAssociations, error)
+	RecommendedProducts(ctx context.Context, partNumber string, limit int, opts ...RequestOption) ([]RecommendedProduct, error)
+	ChangeNotifications(ctx context.Context, digiKeyPartNumber string, opts ...RequestOption) ([]ProductChangeNotification, error)
+	FindAlternates(ctx context.Context, partNumber string, criteria AlternateCriteria, opts ...RequestOption) ([]Alternate, error)
+	Compare(ctx context.Context, partNumbers []string, opts ...RequestOption) (*Comparison, error)
+	LifecycleAudit(ctx context.Context, partNumbers []string, opts ...RequestOption) ([]LifecycleAuditResult, error)
+	Categories(ctx context.Context, opts ...RequestOption) ([]Category, error)
+	CategoriesStream(ctx context.Context, fn func(Category) error, opts ...RequestOption) error
+	CategoryByID(ctx context.Context, id int, opts ...RequestOption) (*Category, error)
+	Manufacturers(ctx context.Context, opts ...RequestOption) ([]Manufacturer, error)
+}
+
+// OrdersAPI is the interface of OrdersService.
+type OrdersAPI interface {
+	Status(ctx context.Context, salesOrderID int, opts ...RequestOption) (*SalesOrder, error)
+	Details(ctx context.Context, salesOrderID int, opts ...RequestOption) ([]LineItem, error)
+	HistoryPage(ctx context.Context, req OrderHistoryRequest, opts ...RequestOption) (*OrderHistoryPage, error)
+	History(ctx context.Context, from, to time.Time, opts ...RequestOption) iter.Seq2[SalesOrder, error]
+	OpenBackorders(ctx context.Context, since time.Time, opts ...RequestOption) ([]Backorder, error)
+	WaitForShipment(ctx context.Context, salesOrderID int, pollInterval time.Duration, progress func(ShipmentProgress), opts ...RequestOption) (*SalesOrder, error)
+}
+
+// ListsAPI is the interface of ListsService.
+type ListsAPI interface {
+	List(ctx context.Context, opts ...RequestOption) ([]List, error)
+	Get(ctx context.Context, listID string, opts ...RequestOption) (*List, error)
+	Create(ctx context.Context, req CreateListRequest, opts ...RequestOption) (string, error)
+	Rename(ctx context.Context, listID, name string, opts ...RequestOption) error
+	Delete(ctx context.Context, listID string, opts ...RequestOption) error
+	Parts(ctx context.Context, listID string, opts ...RequestOption) ([]ListPart, error)
+	AddParts(ctx context.Context, listID string, parts []ListPart, opts ...RequestOption) error
+	RemoveParts(ctx context.Context, listID string, partIDs []string, opts ...RequestOption) error
+}
+
+// QuotesAPI is the interface of QuotesService.
+type QuotesAPI interface {
+	Create(ctx context.Context, req QuoteRequest, opts ...RequestOption) (*Quote, error)
+	Get(ctx context.Context, quoteID int, opts ...RequestOption) (*Quote, error)
+	List(ctx context.Context, opts ...RequestOption) ([]Quote, error)
+}
+
+// BarcodingAPI is the interface of BarcodingService.
+type BarcodingAPI interface {
+	ProductBarcode(ctx context.Context, barcode string, opts ...RequestOption) (*ProductBarcode, error)
+	Product2DBarcode(ctx context.Context, barcode string, opts ...RequestOption) (*Product2DBarcode, error)
+	PackListBarcode(ctx context.Context, barcode string, opts ...RequestOption) (*PackListBarcode, error)
+	PackList2DBarcode(ctx context.Context, barcode string, opts ...RequestOption) (*PackListBarcode, error)
+}
+
+var (
+	_ ProductsAPI  = (*ProductsService)(nil)
+	_ OrdersAPI    = (*OrdersService)(nil)
+	_ ListsAPI     = (*ListsService)(nil)
+	_ QuotesAPI    = (*QuotesService)(nil)
+	_ BarcodingAPI = (*BarcodingService)(nil)
+)
@@ -0,0 +1,82 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import "context"
+
+// AssociationType is the relationship of an associated product to the
+// product it was requested for.
+type AssociationType string
+
+// Association types.
+const (
+	AssociationKit        AssociationType = "Kit"
+	AssociationMating     AssociationType = "Mating"
+	AssociationAssociated AssociationType = "Associated"
+	AssociationForUseWith AssociationType = "ForUseWith"
+)
+
+// ProductSummary is an abbreviated product, as returned in lists of related
+// products.
+type ProductSummary struct {
+	ProductURL                string       `json:"ProductUrl"`
+	ManufacturerProductNumber string       `json:"ManufacturerProductNumber"`
+	Manufacturer              Manufacturer `json:"Manufacturer"`
+	PrimaryPhoto              string       `json:"PrimaryPhoto"`
+	ProductDescription        string       `json:"ProductDescription"`
+	QuantityAvailable         int          `json:"QuantityAvailable"`
+}
+
+// ProductAssociations contains the products associated with a product:
+// kits that contain it, mating products such as the other half of a
+// connector, associated products, and accessories it is used with.
+type ProductAssociations struct {
+	Kits               []ProductSummary `json:"Kits"`
+	MatingProducts     []ProductSummary `json:"MatingProducts"`
+	AssociatedProducts []ProductSummary `json:"AssociatedProducts"`
+	ForUseWithProducts []ProductSummary `json:"ForUseWithProducts"`
+}
+
+// ProductAssociation is an associated product and its association type.
+type ProductAssociation struct {
+	Type    AssociationType
+	Product ProductSummary
+}
+
+// associationsResponse is the response to a product associations request.
+type associationsResponse struct {
+	ProductAssociations ProductAssociations `json:"ProductAssociations"`
+	SearchLocaleUsed    Locale              `json:"SearchLocaleUsed"`
+}
+
+// Associations returns the products associated with the product with the
+// given DigiKey or manufacturer product number.
+func (s *ProductsService) Associations(ctx context.Context, partNumber string, opts ...RequestOption) (*ProductAssociations, error) {
+	resp := associationsResponse{}
+	if err := s.client.getJSON(ctx, productPath(partNumber, "associations"), nil, &resp, opts...); err != nil {
+		return nil, err
+	}
+	return &resp.ProductAssociations, nil
+}
+
+// All returns every associated product tagged with its association type.
+func (a *ProductAssociations) All() []ProductAssociation {
+	var all []ProductAssociation
+	for _, group := range []struct {
+		t        AssociationType
+		products []ProductSummary
+	}{
+		{AssociationKit, a.Kits},
+		{AssociationMating, a.MatingProducts},
+		{AssociationAssociated, a.AssociatedProducts},
+		{AssociationForUseWith, a.ForUseWithProducts},
+	} {
+		for _, p := range group.products {
+			all = append(all, ProductAssociation{Type: group.t, Product: p})
+		}
+	}
+	return all
+}
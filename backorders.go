@@ -0,0 +1,85 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import (
+	"context"
+	"slices"
+	"time"
+)
+
+// Backorder is the backordered quantity of an order line.
+type Backorder struct {
+	SalesOrderID     int
+	PurchaseOrder    string
+	LineItem         LineItem
+	Quantity         int
+	ExpectedShipDate time.Time
+}
+
+// Backordered reports whether some quantity of the line is backordered.
+func (l LineItem) Backordered() bool {
+	return l.QuantityBackOrder > 0
+}
+
+// ExpectedShipDate returns DigiKey's earliest scheduled ship date for the
+// line, or false if no shipment is scheduled.
+func (l LineItem) ExpectedShipDate() (time.Time, bool) {
+	var earliest time.Time
+	for _, s := range l.Schedules {
+		if s.ScheduledDate.IsZero() || s.QuantityScheduled <= 0 {
+			continue
+		}
+		if earliest.IsZero() || s.ScheduledDate.Before(earliest) {
+			earliest = s.ScheduledDate.Time
+		}
+	}
+	return earliest, !earliest.IsZero()
+}
+
+// Backorders returns the backordered lines of the order.
+func (o *SalesOrder) Backorders() []Backorder {
+	var backorders []Backorder
+	for _, l := range o.LineItems {
+		if !l.Backordered() {
+			continue
+		}
+		expected, _ := l.ExpectedShipDate()
+		backorders = append(backorders, Backorder{
+			SalesOrderID:     o.SalesOrderID,
+			PurchaseOrder:    o.PurchaseOrder,
+			LineItem:         l,
+			Quantity:         l.QuantityBackOrder,
+			ExpectedShipDate: expected,
+		})
+	}
+	return backorders
+}
+
+// OpenBackorders returns the open backorders of the orders entered since the
+// given time, ordered by expected ship date with unscheduled backorders
+// last.
+func (s *OrdersService) OpenBackorders(ctx context.Context, since time.Time, opts ...RequestOption) ([]Backorder, error) {
+	var backorders []Backorder
+	for order, err := range s.History(ctx, since, time.Now(), opts...) {
+		if err != nil {
+			return nil, err
+		}
+		backorders = append(backorders, order.Backorders()...)
+	}
+	slices.SortStableFunc(backorders, func(a, b Backorder) int {
+		switch {
+		case a.ExpectedShipDate.IsZero() != b.ExpectedShipDate.IsZero():
+			if a.ExpectedShipDate.IsZero() {
+				return 1
+			}
+			return -1
+		default:
+			return a.ExpectedShipDate.Compare(b.ExpectedShipDate)
+		}
+	})
+	return backorders, nil
+}
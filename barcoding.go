@@ -0,0 +1,96 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import (
+	"context"
+	"net/url"
+)
+
+const barcodingPath = "Barcoding/v3/"
+
+// BarcodingService provides access to the Barcoding V3 API, which decodes
+// the barcodes printed on DigiKey product labels and pack lists.
+type BarcodingService struct {
+	client *Client
+}
+
+// ProductBarcode is the decoded content of a 1D product label barcode.
+type ProductBarcode struct {
+	DigiKeyPartNumber      string `json:"DigiKeyPartNumber"`
+	ManufacturerPartNumber string `json:"ManufacturerPartNumber"`
+	ManufacturerName       string `json:"ManufacturerName"`
+	ProductDescription     string `json:"ProductDescription"`
+	Quantity               int    `json:"Quantity"`
+}
+
+// ProductBarcode decodes a scanned 1D product label barcode.
+func (s *BarcodingService) ProductBarcode(ctx context.Context, barcode string, opts ...RequestOption) (*ProductBarcode, error) {
+	resp := &ProductBarcode{}
+	endpoint := barcodingPath + "ProductBarcodes/" + url.PathEscape(barcode)
+	if err := s.client.getJSON(ctx, endpoint, nil, resp, opts...); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Product2DBarcode is the decoded content of a 2D (Data Matrix) product
+// label barcode.
+type Product2DBarcode struct {
+	DigiKeyPartNumber      string `json:"DigiKeyPartNumber"`
+	ManufacturerPartNumber string `json:"ManufacturerPartNumber"`
+	ManufacturerName       string `json:"ManufacturerName"`
+	ProductDescription     string `json:"ProductDescription"`
+	Quantity               int    `json:"Quantity"`
+	SalesOrderID           int    `json:"SalesorderId"`
+	InvoiceID              int    `json:"InvoiceId"`
+	PurchaseOrder          string `json:"PurchaseOrder"`
+	CustomerID             int    `json:"CustomerId"`
+	LotCode                string `json:"LotCode"`
+	DateCode               string `json:"DateCode"`
+	CountryOfOrigin        string `json:"CountryOfOrigin"`
+}
+
+// PackListBarcode is the decoded content of a 1D or 2D pack list barcode.
+type PackListBarcode struct {
+	SalesOrderID  int    `json:"SalesorderId"`
+	InvoiceID     int    `json:"InvoiceId"`
+	PurchaseOrder string `json:"PurchaseOrder"`
+	CustomerID    int    `json:"CustomerId"`
+}
+
+// Product2DBarcode decodes a scanned 2D product label barcode. The raw Data
+// Matrix payload, including its group separator control characters, can be
+// passed unchanged.
+func (s *BarcodingService) Product2DBarcode(ctx context.Context, barcode string, opts ...RequestOption) (*Product2DBarcode, error) {
+	resp := &Product2DBarcode{}
+	endpoint := barcodingPath + "Product2DBarcodes/" + url.PathEscape(barcode)
+	if err := s.client.getJSON(ctx, endpoint, nil, resp, opts...); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// PackListBarcode decodes a scanned 1D pack list barcode.
+func (s *BarcodingService) PackListBarcode(ctx context.Context, barcode string, opts ...RequestOption) (*PackListBarcode, error) {
+	resp := &PackListBarcode{}
+	endpoint := barcodingPath + "PackListBarcodes/" + url.PathEscape(barcode)
+	if err := s.client.getJSON(ctx, endpoint, nil, resp, opts...); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// PackList2DBarcode decodes a scanned 2D pack list barcode. The raw Data
+// Matrix payload can be passed unchanged.
+func (s *BarcodingService) PackList2DBarcode(ctx context.Context, barcode string, opts ...RequestOption) (*PackListBarcode, error) {
+	resp := &PackListBarcode{}
+	endpoint := barcodingPath + "PackList2DBarcodes/" + url.PathEscape(barcode)
+	if err := s.client.getJSON(ctx, endpoint, nil, resp, opts...); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
@@ -0,0 +1,325 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+// Package bom resolves bills of materials against DigiKey, enriching each
+// line with the matching DigiKey part, its stock, pricing, lead time, and
+// lifecycle status.
+package bom
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/apidepot/digikey"
+)
+
+// ErrNoMatch is reported for lines that match no DigiKey product.
+var ErrNoMatch = errors.New("no matching product")
+
+// BOMLine is a line of a bill of materials.
+type BOMLine struct {
+	MPN          string
+	Manufacturer string
+	Quantity     int
+	RefDes       string
+}
+
+// Confidence is how closely the DigiKey product matched a BOM line.
+type Confidence int
+
+// Match confidences, in increasing order.
+const (
+	ConfidenceNone Confidence = iota
+	// ConfidenceFuzzy is a keyword search hit whose MPN differs.
+	ConfidenceFuzzy
+	// ConfidenceMPN is an exact MPN match whose manufacturer differs from,
+	// or was missing from, the BOM line.
+	ConfidenceMPN
+	// ConfidenceExact is an exact MPN and manufacturer match.
+	ConfidenceExact
+)
+
+var confidenceNames = map[Confidence]string{
+	ConfidenceNone:  "None",
+	ConfidenceFuzzy: "Fuzzy",
+	ConfidenceMPN:   "MPN",
+	ConfidenceExact: "Exact",
+}
+
+// String implements fmt.Stringer.
+func (c Confidence) String() string {
+	if name, ok := confidenceNames[c]; ok {
+		return name
+	}
+	return confidenceNames[ConfidenceNone]
+}
+
+// EnrichedLine is a BOM line resolved against DigiKey. Err is set if the
+// line could not be resolved, in which case only BOMLine is set.
+// Unavailable lists the sections whose data is missing because the API
+// serving them was unavailable, whose fields are left as zero values.
+type EnrichedLine struct {
+	BOMLine
+	DigiKeyPartNumber string
+	Product           *digikey.Product
+	Variation         *digikey.ProductVariation
+	QuantityAvailable int
+	UnitPrice         digikey.Money
+	ExtendedPrice     digikey.Money
+	LeadTimeWeeks     int
+	Lifecycle         digikey.Lifecycle
+	Confidence        Confidence
+	Unavailable       Section
+	Err               error
+}
+
+// Enricher resolves BOM lines against DigiKey.
+type Enricher struct {
+	client      *digikey.Client
+	policy      DegradationPolicy
+	requestOpts []digikey.RequestOption
+}
+
+// Option applies an option to an enricher.
+type Option func(*Enricher)
+
+// WithDegradationPolicy sets what enrichment does when an API is
+// unavailable, by default DegradeAll.
+func WithDegradationPolicy(p DegradationPolicy) Option {
+	return func(en *Enricher) {
+		en.policy = p
+	}
+}
+
+// WithRequestOptions sets request options applied to every request.
+func WithRequestOptions(opts ...digikey.RequestOption) Option {
+	return func(en *Enricher) {
+		en.requestOpts = append(en.requestOpts, opts...)
+	}
+}
+
+// NewEnricher creates an enricher that looks up lines using the client.
+func NewEnricher(c *digikey.Client, opts ...Option) *Enricher {
+	en := &Enricher{client: c, policy: DegradeAll}
+	for _, opt := range opts {
+		opt(en)
+	}
+	return en
+}
+
+// Enrich resolves each BOM line against DigiKey with the default
+// degradation policy. See Enricher.Enrich.
+func Enrich(ctx context.Context, c *digikey.Client, lines []BOMLine, opts ...digikey.RequestOption) ([]EnrichedLine, error) {
+	return NewEnricher(c, WithRequestOptions(opts...)).Enrich(ctx, lines)
+}
+
+// Enrich resolves each BOM line against DigiKey, with as many lookups in
+// flight as the client's Concurrency. Lines are matched by exact
+// MPN, preferring the BOM manufacturer, and fall back to the top keyword
+// search hit. The packaging option is the cheapest at the line quantity
+// among those with enough stock, or the cheapest overall if none has.
+// Failed lines are reported in their Err field, and sections missing
+// because an API was unavailable in their Unavailable field. Only
+// cancelling the context, or an unavailable section the degradation policy
+// requires, stops enrichment.
+func (en *Enricher) Enrich(ctx context.Context, lines []BOMLine) ([]EnrichedLine, error) {
+	parent := ctx
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+	enriched := make([]EnrichedLine, len(lines))
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, en.client.Concurrency())
+	for i, l := range lines {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			e, err := en.enrichLine(ctx, l)
+			enriched[i] = e
+			if missing := e.Unavailable &^ en.policy.Optional; missing != 0 {
+				cancel(fmt.Errorf("error enriching line %d (%s): %s unavailable: %w", i+1, l.MPN, missing, err))
+			}
+		}()
+	}
+	wg.Wait()
+	if err := parent.Err(); err != nil {
+		return nil, err
+	}
+	if err := context.Cause(ctx); err != nil {
+		return nil, err
+	}
+	return enriched, nil
+}
+
+// enrichLine resolves the line, returning the error of an unavailable API
+// along with the line if any of its sections are unavailable.
+func (en *Enricher) enrichLine(ctx context.Context, l BOMLine) (EnrichedLine, error) {
+	e := EnrichedLine{BOMLine: l}
+	product, confidence, err := resolve(ctx, en.client, l, en.requestOpts)
+	if err != nil {
+		if unavailable(ctx, err) {
+			e.Unavailable = AllSections
+			e.Err = fmt.Errorf("%w: %w", ErrUnavailable, err)
+			return e, err
+		}
+		e.Err = err
+		return e, nil
+	}
+	e.Product = product
+	e.Confidence = confidence
+	e.QuantityAvailable = product.QuantityAvailable
+	e.LeadTimeWeeks = ParseLeadWeeks(product.ManufacturerLeadWeeks)
+	e.Lifecycle = product.Lifecycle()
+
+	// Search results lack price breaks when DigiKey's pricing service is
+	// degraded; ask the pricing API for them.
+	var pricingErr error
+	if !hasPricing(product) {
+		pricingErr = fillPricing(ctx, en.client, product, en.requestOpts)
+		if pricingErr != nil && unavailable(ctx, pricingErr) {
+			e.Unavailable = SectionPricing
+		} else {
+			pricingErr = nil
+		}
+	}
+
+	qty := max(l.Quantity, 1)
+	if v, price, ok := ChooseVariation(product.ProductVariations, qty); ok {
+		e.Variation = v
+		e.DigiKeyPartNumber = v.DigiKeyProductNumber
+		e.QuantityAvailable = v.QuantityAvailableForPackageType
+		e.UnitPrice, _ = digikey.UnitPriceAt(v.StandardPricing, qty)
+		e.ExtendedPrice = price
+	} else if len(product.ProductVariations) > 0 && !e.Available(SectionPricing) {
+		// Without prices any packaging will do to report the stock.
+		v := &product.ProductVariations[0]
+		e.Variation = v
+		e.DigiKeyPartNumber = v.DigiKeyProductNumber
+		e.QuantityAvailable = v.QuantityAvailableForPackageType
+	}
+	return e, pricingErr
+}
+
+// hasPricing reports whether any packaging option of the product has price
+// breaks.
+func hasPricing(p *digikey.Product) bool {
+	for _, v := range p.ProductVariations {
+		if len(v.StandardPricing) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// fillPricing replaces the packaging options of the product with those of
+// its pricing, if the pricing API has any.
+func fillPricing(ctx context.Context, c *digikey.Client, p *digikey.Product, opts []digikey.RequestOption) error {
+	pricings, err := c.Products.Pricing(ctx, p.ManufacturerProductNumber, opts...)
+	if err != nil {
+		return err
+	}
+	for _, pp := range pricings {
+		if pp.Manufacturer.ID == p.Manufacturer.ID && strings.EqualFold(pp.ManufacturerProductNumber, p.ManufacturerProductNumber) &&
+			len(pp.ProductVariations) > 0 {
+			p.ProductVariations = pp.ProductVariations
+			return nil
+		}
+	}
+	return nil
+}
+
+// resolve finds the product matching the line.
+func resolve(ctx context.Context, c *digikey.Client, l BOMLine, opts []digikey.RequestOption) (*digikey.Product, Confidence, error) {
+	matches, err := c.Products.ByManufacturerPartNumber(ctx, l.MPN, opts...)
+	if err != nil {
+		return nil, ConfidenceNone, err
+	}
+	for _, m := range matches {
+		if l.Manufacturer != "" && SameManufacturer(m.Product.Manufacturer.Name, l.Manufacturer) {
+			return &m.Product, ConfidenceExact, nil
+		}
+	}
+	if len(matches) > 0 {
+		return &matches[0].Product, ConfidenceMPN, nil
+	}
+
+	keywords := strings.TrimSpace(l.Manufacturer + " " + l.MPN)
+	resp, err := c.Products.KeywordSearch(ctx, digikey.KeywordRequest{Keywords: keywords, Limit: 1}, opts...)
+	if err != nil {
+		return nil, ConfidenceNone, err
+	}
+	if len(resp.Products) == 0 {
+		return nil, ConfidenceNone, ErrNoMatch
+	}
+	return &resp.Products[0], ConfidenceFuzzy, nil
+}
+
+// ChooseVariation returns the packaging option with the lowest extended
+// price at qty, including any reeling fee, preferring options with at least
+// qty in stock. It returns false if no option can be ordered at qty.
+func ChooseVariation(variations []digikey.ProductVariation, qty int) (*digikey.ProductVariation, digikey.Money, bool) {
+	var best *digikey.ProductVariation
+	var bestPrice digikey.Money
+	bestStocked := false
+	for i := range variations {
+		v := &variations[i]
+		price, err := digikey.ExtendedPrice(v.StandardPricing, qty, v.MinimumOrderQuantity, v.DigiReelFee)
+		if err != nil {
+			continue
+		}
+		stocked := v.QuantityAvailableForPackageType >= qty
+		if best == nil || (stocked && !bestStocked) || (stocked == bestStocked && price.Amount < bestPrice.Amount) {
+			best, bestPrice, bestStocked = v, price, stocked
+		}
+	}
+	return best, bestPrice, best != nil
+}
+
+// SameManufacturer reports whether two manufacturer names refer to the same
+// manufacturer, ignoring case, punctuation, and one name being a prefix of
+// the other, e.g. "Texas Instruments" and "TEXAS INSTRUMENTS INC.".
+func SameManufacturer(a, b string) bool {
+	a, b = normalizeName(a), normalizeName(b)
+	if a == "" || b == "" {
+		return false
+	}
+	return strings.HasPrefix(a, b) || strings.HasPrefix(b, a)
+}
+
+func normalizeName(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, s)
+}
+
+// ParseLeadWeeks returns the number of weeks in a manufacturer lead time
+// such as "12 Weeks", or zero if it contains no number.
+func ParseLeadWeeks(s string) int {
+	start := strings.IndexFunc(s, unicode.IsDigit)
+	if start < 0 {
+		return 0
+	}
+	end := start
+	for end < len(s) && s[end] >= '0' && s[end] <= '9' {
+		end++
+	}
+	weeks, _ := strconv.Atoi(s[start:end])
+	return weeks
+}
@@ -0,0 +1,183 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package bom
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ErrNoMPNColumn is returned when a CSV file has no header row containing
+// an MPN column.
+var ErrNoMPNColumn = errors.New("no MPN column found")
+
+// ColumnMapping lists the header names, matched case-insensitively and
+// ignoring surrounding whitespace, that may hold each BOM field.
+type ColumnMapping struct {
+	MPN          []string
+	Manufacturer []string
+	Quantity     []string
+	RefDes       []string
+}
+
+// DefaultColumnMapping recognizes the column names used by common EDA tools
+// and distributors.
+var DefaultColumnMapping = ColumnMapping{
+	MPN: []string{
+		"mpn", "manufacturer part number", "manufacturer part #",
+		"mfr part number", "mfr part #", "mfr. #", "mfg part number",
+		"mfg part #", "manufacturer_part_number", "part number", "part",
+	},
+	Manufacturer: []string{
+		"manufacturer", "manufacturer name", "mfr", "mfr.", "mfg",
+		"mfg name", "manufacturer_name",
+	},
+	Quantity: []string{
+		"quantity", "qty", "qty.", "qnty", "count", "quantity per board",
+	},
+	RefDes: []string{
+		"refdes", "ref des", "reference", "references", "designator",
+		"designators", "reference designator", "reference designators",
+	},
+}
+
+// ReadCSV reads BOM lines from a CSV file exported by an arbitrary tool.
+// The delimiter (comma, semicolon, or tab) is detected from the content.
+// Rows before the header row, which is the first row with an MPN column, are
+// skipped, as are rows without an MPN. A missing or empty quantity defaults
+// to the number of reference designators, or 1 if there are none.
+// Quantities may contain thousands separators and a zero fraction, e.g.
+// "1,000" or "10.0".
+func ReadCSV(r io.Reader, mapping ColumnMapping) ([]BOMLine, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	data = bytes.TrimPrefix(data, []byte("\uFEFF"))
+
+	cr := csv.NewReader(bytes.NewReader(data))
+	cr.Comma = detectDelimiter(data)
+	cr.FieldsPerRecord = -1
+	cr.LazyQuotes = true
+	cr.TrimLeadingSpace = true
+	records, err := cr.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("error reading BOM CSV: %w", err)
+	}
+
+	header := -1
+	var cols columns
+	for i, rec := range records {
+		if c, ok := findColumns(rec, mapping); ok {
+			header, cols = i, c
+			break
+		}
+	}
+	if header < 0 {
+		return nil, ErrNoMPNColumn
+	}
+
+	var lines []BOMLine
+	for i, rec := range records[header+1:] {
+		l := BOMLine{
+			MPN:          field(rec, cols.mpn),
+			Manufacturer: field(rec, cols.manufacturer),
+			RefDes:       field(rec, cols.refDes),
+		}
+		if l.MPN == "" {
+			continue
+		}
+		qty := field(rec, cols.quantity)
+		if qty == "" {
+			l.Quantity = max(countRefDes(l.RefDes), 1)
+		} else if l.Quantity, err = parseQuantity(qty); err != nil {
+			// Report the row number as shown by spreadsheets.
+			return nil, fmt.Errorf("row %d: invalid quantity %q", header+i+2, qty)
+		}
+		lines = append(lines, l)
+	}
+	return lines, nil
+}
+
+// columns holds the indexes of the mapped columns, or -1 if absent.
+type columns struct {
+	mpn, manufacturer, quantity, refDes int
+}
+
+func findColumns(rec []string, mapping ColumnMapping) (columns, bool) {
+	c := columns{
+		mpn:          findColumn(rec, mapping.MPN),
+		manufacturer: findColumn(rec, mapping.Manufacturer),
+		quantity:     findColumn(rec, mapping.Quantity),
+		refDes:       findColumn(rec, mapping.RefDes),
+	}
+	return c, c.mpn >= 0
+}
+
+// findColumn returns the index of the first column whose name matches the
+// earliest possible name in names, so more specific names take priority.
+func findColumn(rec []string, names []string) int {
+	for _, name := range names {
+		for i, h := range rec {
+			if strings.EqualFold(strings.TrimSpace(h), name) {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+func field(rec []string, i int) string {
+	if i < 0 || i >= len(rec) {
+		return ""
+	}
+	return strings.TrimSpace(rec[i])
+}
+
+func parseQuantity(s string) (int, error) {
+	s = strings.ReplaceAll(s, ",", "")
+	s = strings.ReplaceAll(s, " ", "")
+	if n, err := strconv.Atoi(s); err == nil {
+		return n, nil
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || f != float64(int(f)) {
+		return 0, errors.New("not a whole number")
+	}
+	return int(f), nil
+}
+
+func countRefDes(s string) int {
+	return len(strings.FieldsFunc(s, func(r rune) bool {
+		return r == ',' || r == ';' || r == ' ' || r == '\t'
+	}))
+}
+
+// detectDelimiter returns the most frequent candidate delimiter in the first
+// lines of data.
+func detectDelimiter(data []byte) rune {
+	counts := map[rune]int{}
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	for n := 0; n < 10 && sc.Scan(); n++ {
+		line := sc.Text()
+		for _, d := range []rune{',', ';', '\t'} {
+			counts[d] += strings.Count(line, string(d))
+		}
+	}
+	best := ','
+	for _, d := range []rune{';', '\t'} {
+		if counts[d] > counts[best] {
+			best = d
+		}
+	}
+	return best
+}
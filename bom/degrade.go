@@ -0,0 +1,104 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package bom
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/apidepot/digikey"
+)
+
+// Section is a set of the kinds of data of an enriched line, which may be
+// unavailable when the DigiKey API serving them is.
+type Section uint8
+
+// Sections of an enriched line. The match, availability, lead time, and
+// lifecycle come from the product search API; the pricing comes from the
+// search results or, when they have none, the product pricing API.
+const (
+	SectionMatch Section = 1 << iota
+	SectionAvailability
+	SectionPricing
+	SectionLeadTime
+	SectionLifecycle
+
+	// AllSections is every section.
+	AllSections = SectionMatch | SectionAvailability | SectionPricing | SectionLeadTime | SectionLifecycle
+)
+
+var sectionNames = []string{"match", "availability", "pricing", "lead time", "lifecycle"}
+
+// Names returns the names of the sections in the set.
+func (s Section) Names() []string {
+	var names []string
+	for i, name := range sectionNames {
+		if s&(1<<i) != 0 {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// String implements fmt.Stringer, listing the sections separated by
+// commas.
+func (s Section) String() string {
+	return strings.Join(s.Names(), ", ")
+}
+
+// DegradationPolicy decides what enrichment does when a DigiKey API that a
+// line's data comes from is unavailable: failing, throttled, unreachable,
+// or cut off by the client's circuit breaker.
+type DegradationPolicy struct {
+	// Optional are the sections that enrichment can do without. They are
+	// recorded in the Unavailable field of the lines missing them, and
+	// reports show them as unavailable rather than as zeros. If a section
+	// that is not optional is unavailable, enrichment stops and returns
+	// the API's error.
+	Optional Section
+}
+
+// Degradation policies.
+var (
+	// DegradeAll enriches every line it can and marks the sections of the
+	// others as unavailable. It is the default.
+	DegradeAll = DegradationPolicy{Optional: AllSections}
+	// FailFast stops enrichment as soon as any API is unavailable, for
+	// callers that would rather have no report than an incomplete one.
+	FailFast = DegradationPolicy{}
+)
+
+// ErrUnavailable is wrapped by the errors of lines and line costs whose
+// data is unavailable.
+var ErrUnavailable = errors.New("data unavailable")
+
+// Available reports whether every given section of the line was available.
+func (l *EnrichedLine) Available(s Section) bool {
+	return l.Unavailable&s == 0
+}
+
+// unavailable reports whether err means that the API answering a request
+// is unavailable, rather than that the request itself is wrong.
+func unavailable(ctx context.Context, err error) bool {
+	var (
+		apiErr digikey.Error
+		netErr net.Error
+	)
+	switch {
+	case ctx.Err() != nil:
+		// The caller gave up; the API may be fine.
+		return false
+	case errors.As(err, &apiErr):
+		return apiErr.StatusCode >= 500 || apiErr.StatusCode == http.StatusTooManyRequests
+	}
+	return errors.Is(err, digikey.ErrQuotaExceeded) ||
+		errors.Is(err, digikey.ErrCircuitOpen) ||
+		errors.Is(err, context.DeadlineExceeded) ||
+		errors.As(err, &netErr)
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package bom_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/apidepot/digikey"
+	"github.com/apidepot/digikey/bom"
+	"github.com/apidepot/digikey/digikeytest"
+)
+
+// newDegradedServer starts a fake API whose search results lack prices and
+// whose pricing endpoint is unavailable.
+func newDegradedServer(t *testing.T) (*digikeytest.Server, *digikey.Client) {
+	t.Helper()
+	products := digikeytest.DefaultCatalog()
+	for i := range products {
+		for j := range products[i].ProductVariations {
+			products[i].ProductVariations[j].StandardPricing = nil
+		}
+	}
+	srv := digikeytest.NewServer("id", "secret", products...)
+	t.Cleanup(srv.Close)
+	api := srv.Config.Handler
+	srv.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/pricing") {
+			http.Error(w, `{"ErrorMessage":"Service unavailable"}`, http.StatusServiceUnavailable)
+			return
+		}
+		api.ServeHTTP(w, r)
+	})
+	c, err := srv.NewClient("id", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return srv, c
+}
+
+var degradedLines = []bom.BOMLine{{MPN: "LM358DR", Manufacturer: "Texas Instruments", Quantity: 10, RefDes: "U1"}}
+
+func TestEnrichDegradesPricing(t *testing.T) {
+	_, c := newDegradedServer(t)
+	enriched, err := bom.NewEnricher(c).Enrich(context.Background(), degradedLines)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := enriched[0]
+	if e.Err != nil || e.Confidence != bom.ConfidenceExact {
+		t.Fatalf("line = %v, %v, want an exact match", e.Err, e.Confidence)
+	}
+	if e.Unavailable != bom.SectionPricing || e.Available(bom.SectionPricing) || !e.Available(bom.SectionAvailability) {
+		t.Errorf("Unavailable = %v, want pricing", e.Unavailable)
+	}
+	if e.DigiKeyPartNumber == "" || e.QuantityAvailable == 0 {
+		t.Errorf("line has no packaging or stock: %+v", e)
+	}
+
+	rollups, err := bom.CostRollup(context.Background(), enriched, []int{1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rollups[0].Lines[0].Err; !errors.Is(err, bom.ErrUnavailable) {
+		t.Errorf("line cost error = %v, want ErrUnavailable", err)
+	}
+}
+
+func TestEnrichFailFast(t *testing.T) {
+	_, c := newDegradedServer(t)
+	for _, policy := range []bom.DegradationPolicy{bom.FailFast, {Optional: bom.AllSections &^ bom.SectionPricing}} {
+		_, err := bom.NewEnricher(c, bom.WithDegradationPolicy(policy)).Enrich(context.Background(), degradedLines)
+		var apiErr digikey.Error
+		if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusServiceUnavailable {
+			t.Errorf("Enrich(%v) error = %v, want 503", policy, err)
+		}
+	}
+}
+
+func TestEnrichSearchUnavailable(t *testing.T) {
+	srv := digikeytest.NewServer("id", "secret")
+	defer srv.Close()
+	c, err := srv.NewClient("id", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv.FailNext(http.StatusServiceUnavailable, `{"ErrorMessage":"Service unavailable"}`)
+	enriched, err := bom.Enrich(context.Background(), c, degradedLines)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := enriched[0]
+	if e.Unavailable != bom.AllSections || !errors.Is(e.Err, bom.ErrUnavailable) {
+		t.Errorf("line = %v, %v, want every section unavailable", e.Unavailable, e.Err)
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package bom
+
+// LeadWeeks returns the number of weeks until the line's quantity can ship:
+// zero if DigiKey stocks enough of the chosen packaging, otherwise the
+// manufacturer lead time. It returns false if the lead time is unknown
+// because the line failed enrichment or is short with no published lead
+// time.
+func (l *EnrichedLine) LeadWeeks() (int, bool) {
+	if l.Err != nil || l.Product == nil {
+		return 0, false
+	}
+	if l.QuantityAvailable >= max(l.Quantity, 1) {
+		return 0, true
+	}
+	if l.LeadTimeWeeks <= 0 {
+		return 0, false
+	}
+	return l.LeadTimeWeeks, true
+}
+
+// LeadTime is the critical-path lead time of a BOM.
+type LeadTime struct {
+	// Weeks is the longest lead time of any line with a known lead time.
+	Weeks int
+	// Gating lists the lines whose lead time is Weeks, if it is not zero.
+	Gating []*EnrichedLine
+	// Unknown lists the lines whose lead time is unknown, any of which may
+	// gate the build.
+	Unknown []*EnrichedLine
+}
+
+// CriticalLeadTime returns the lead time of the BOM, the longest lead time
+// of its lines, and the lines that gate it.
+func CriticalLeadTime(lines []EnrichedLine) LeadTime {
+	var lt LeadTime
+	for i := range lines {
+		l := &lines[i]
+		weeks, ok := l.LeadWeeks()
+		switch {
+		case !ok:
+			lt.Unknown = append(lt.Unknown, l)
+		case weeks > lt.Weeks:
+			lt.Weeks = weeks
+			lt.Gating = []*EnrichedLine{l}
+		case weeks == lt.Weeks && weeks > 0:
+			lt.Gating = append(lt.Gating, l)
+		}
+	}
+	return lt
+}
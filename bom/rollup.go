@@ -0,0 +1,113 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package bom
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/apidepot/digikey"
+)
+
+// ErrNoPricing is reported for lines whose product has no packaging option
+// that can be ordered at the required quantity.
+var ErrNoPricing = errors.New("no orderable pricing")
+
+// LineCost is the cost of a BOM line at a build quantity. Err is set if the
+// line cannot be costed, in which case it is excluded from the total.
+type LineCost struct {
+	Line              *EnrichedLine
+	DigiKeyPartNumber string
+	// Required is the number of units the build needs.
+	Required int
+	// OrderQuantity is the number of units to order, which may exceed
+	// Required because of minimum order quantities or a cheaper larger
+	// price break.
+	OrderQuantity int
+	UnitPrice     digikey.Money
+	ExtendedPrice digikey.Money
+	Err           error
+}
+
+// Rollup is the cost of a BOM at a build quantity.
+type Rollup struct {
+	BuildQuantity int
+	Lines         []LineCost
+	Total         digikey.Money
+	// PerUnit is Total divided by BuildQuantity.
+	PerUnit digikey.Money
+}
+
+// CostRollup returns the cost of building each of the given quantities of
+// the enriched BOM. Each line is costed independently at each build
+// quantity using the cheapest packaging option and order quantity, since
+// the best packaging at one unit is rarely the best at a thousand. Lines
+// that failed enrichment, whose pricing is unavailable, that cannot be
+// ordered, or that are priced in another currency than the lines before
+// them, are reported in their LineCost's Err field.
+func CostRollup(ctx context.Context, lines []EnrichedLine, quantities []int) ([]Rollup, error) {
+	rollups := make([]Rollup, 0, len(quantities))
+	for _, q := range quantities {
+		if q <= 0 {
+			return nil, digikey.ErrInvalidQuantity
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		r := Rollup{BuildQuantity: q, Lines: make([]LineCost, len(lines))}
+		for i := range lines {
+			lc := costLine(&lines[i], q)
+			if lc.Err == nil {
+				total, err := r.Total.Add(lc.ExtendedPrice)
+				if err != nil {
+					lc.Err = err
+				} else {
+					r.Total = total
+				}
+			}
+			r.Lines[i] = lc
+		}
+		r.PerUnit = r.Total.Mul(1 / float64(q))
+		rollups = append(rollups, r)
+	}
+	return rollups, nil
+}
+
+func costLine(l *EnrichedLine, buildQty int) LineCost {
+	lc := LineCost{Line: l, Required: max(l.Quantity, 1) * buildQty}
+	if l.Err != nil {
+		lc.Err = l.Err
+		return lc
+	}
+	if l.Product == nil {
+		lc.Err = ErrNoMatch
+		return lc
+	}
+	if !l.Available(SectionPricing) {
+		lc.Err = fmt.Errorf("pricing %w", ErrUnavailable)
+		return lc
+	}
+	var best *digikey.OrderPlan
+	for _, v := range l.Product.ProductVariations {
+		plan, err := digikey.OptimalOrder(v.StandardPricing, lc.Required, v.MinimumOrderQuantity, v.DigiReelFee)
+		if err != nil {
+			continue
+		}
+		if best == nil || plan.ExtendedPrice.Amount < best.ExtendedPrice.Amount {
+			best = &plan
+			lc.DigiKeyPartNumber = v.DigiKeyProductNumber
+		}
+	}
+	if best == nil {
+		lc.Err = ErrNoPricing
+		return lc
+	}
+	lc.OrderQuantity = best.Quantity
+	lc.UnitPrice = best.UnitPrice
+	lc.ExtendedPrice = best.ExtendedPrice
+	return lc
+}
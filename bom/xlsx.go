@@ -0,0 +1,201 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package bom
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// xlsxSheet is the name of the worksheet written by WriteXLSX.
+const xlsxSheet = "BOM"
+
+// xlsxColumns are the headers and widths of the worksheet columns.
+var xlsxColumns = []struct {
+	header string
+	width  float64
+}{
+	{"RefDes", 16},
+	{"MPN", 22},
+	{"Manufacturer", 20},
+	{"Qty", 8},
+	{"DigiKey Part #", 22},
+	{"Description", 40},
+	{"Available", 12},
+	{"Unit Price", 12},
+	{"Extended Price", 14},
+	{"Lead Time (wk)", 14},
+	{"Lifecycle", 18},
+	{"Match", 8},
+	{"Notes", 30},
+}
+
+// WriteXLSX writes the enriched lines to w as a formatted XLSX workbook,
+// with DigiKey part numbers linked to their product pages and a total row
+// summing the extended prices.
+func WriteXLSX(w io.Writer, lines []EnrichedLine) error {
+	f := excelize.NewFile()
+	defer f.Close()
+	if err := f.SetSheetName("Sheet1", xlsxSheet); err != nil {
+		return err
+	}
+
+	styles, err := newXLSXStyles(f)
+	if err != nil {
+		return err
+	}
+
+	for i, col := range xlsxColumns {
+		name, _ := excelize.ColumnNumberToName(i + 1)
+		if err := f.SetColWidth(xlsxSheet, name, name, col.width); err != nil {
+			return err
+		}
+		if err := f.SetCellStr(xlsxSheet, name+"1", col.header); err != nil {
+			return err
+		}
+	}
+	lastCol, _ := excelize.ColumnNumberToName(len(xlsxColumns))
+	if err := f.SetCellStyle(xlsxSheet, "A1", lastCol+"1", styles.header); err != nil {
+		return err
+	}
+
+	for i, l := range lines {
+		if err := writeXLSXLine(f, i+2, l, styles); err != nil {
+			return err
+		}
+	}
+
+	totalRow := len(lines) + 2
+	total := fmt.Sprintf("I%d", totalRow)
+	if err := f.SetCellStr(xlsxSheet, fmt.Sprintf("H%d", totalRow), "Total"); err != nil {
+		return err
+	}
+	if len(lines) > 0 {
+		if err := f.SetCellFormula(xlsxSheet, total, fmt.Sprintf("SUM(I2:I%d)", totalRow-1)); err != nil {
+			return err
+		}
+	}
+	if err := f.SetCellStyle(xlsxSheet, fmt.Sprintf("H%d", totalRow), total, styles.total); err != nil {
+		return err
+	}
+
+	if err := f.AutoFilter(xlsxSheet, fmt.Sprintf("A1:%s%d", lastCol, max(totalRow-1, 1)), nil); err != nil {
+		return err
+	}
+	if err := f.SetPanes(xlsxSheet, &excelize.Panes{
+		Freeze:      true,
+		YSplit:      1,
+		TopLeftCell: "A2",
+		ActivePane:  "bottomLeft",
+	}); err != nil {
+		return err
+	}
+	return f.Write(w)
+}
+
+func writeXLSXLine(f *excelize.File, row int, l EnrichedLine, styles *xlsxStyles) error {
+	cell := func(col string) string { return fmt.Sprintf("%s%d", col, row) }
+	description, productURL, notes := "", "", ""
+	if l.Product != nil {
+		description = l.Product.Description.ProductDescription
+		productURL = l.Product.ProductURL
+	}
+	if l.Err != nil {
+		notes = l.Err.Error()
+	} else if l.Unavailable != 0 {
+		notes = fmt.Sprintf("%s %s", l.Unavailable, ErrUnavailable)
+	}
+
+	values := []any{
+		l.RefDes,
+		l.MPN,
+		l.Manufacturer,
+		l.Quantity,
+		l.DigiKeyPartNumber,
+		description,
+		l.QuantityAvailable,
+		l.UnitPrice.Amount,
+		l.ExtendedPrice.Amount,
+		l.LeadTimeWeeks,
+		l.Lifecycle.String(),
+		l.Confidence.String(),
+		notes,
+	}
+	// Leave the columns of missing data blank rather than reporting
+	// misleading zeros.
+	if l.Err != nil {
+		for _, i := range []int{6, 7, 8, 9} {
+			values[i] = nil
+		}
+		values[10], values[11] = "", l.Confidence.String()
+	}
+	if !l.Available(SectionPricing) {
+		values[7], values[8] = nil, nil
+	}
+	if err := f.SetSheetRow(xlsxSheet, cell("A"), &values); err != nil {
+		return err
+	}
+	if err := f.SetCellStyle(xlsxSheet, cell("H"), cell("H"), styles.unitPrice); err != nil {
+		return err
+	}
+	if err := f.SetCellStyle(xlsxSheet, cell("I"), cell("I"), styles.price); err != nil {
+		return err
+	}
+	if productURL != "" && l.DigiKeyPartNumber != "" {
+		if err := f.SetCellHyperLink(xlsxSheet, cell("E"), productURL, "External"); err != nil {
+			return err
+		}
+		if err := f.SetCellStyle(xlsxSheet, cell("E"), cell("E"), styles.link); err != nil {
+			return err
+		}
+	}
+	if l.Lifecycle.Risky() || l.Err != nil {
+		if err := f.SetCellStyle(xlsxSheet, cell("K"), cell("K"), styles.warning); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// xlsxStyles holds the style IDs used by WriteXLSX.
+type xlsxStyles struct {
+	header, unitPrice, price, total, link, warning int
+}
+
+func newXLSXStyles(f *excelize.File) (*xlsxStyles, error) {
+	unitPriceFormat := "#,##0.00000"
+	priceFormat := "#,##0.00"
+	defs := []*excelize.Style{
+		{
+			Font:      &excelize.Font{Bold: true},
+			Fill:      excelize.Fill{Type: "pattern", Color: []string{"D9E1F2"}, Pattern: 1},
+			Alignment: &excelize.Alignment{Vertical: "center"},
+		},
+		{CustomNumFmt: &unitPriceFormat},
+		{CustomNumFmt: &priceFormat},
+		{Font: &excelize.Font{Bold: true}, CustomNumFmt: &priceFormat},
+		{Font: &excelize.Font{Color: "0563C1", Underline: "single"}},
+		{Font: &excelize.Font{Color: "C00000", Bold: true}},
+	}
+	ids := make([]int, len(defs))
+	for i, d := range defs {
+		id, err := f.NewStyle(d)
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = id
+	}
+	return &xlsxStyles{
+		header:    ids[0],
+		unitPrice: ids[1],
+		price:     ids[2],
+		total:     ids[3],
+		link:      ids[4],
+		warning:   ids[5],
+	}, nil
+}
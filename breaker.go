@@ -0,0 +1,145 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned without sending the request while a circuit
+// breaker is open.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// CircuitState is the state of a circuit breaker.
+type CircuitState int
+
+// Circuit breaker states.
+const (
+	// CircuitClosed lets requests through, counting consecutive failures.
+	CircuitClosed CircuitState = iota
+	// CircuitOpen fails requests fast until the cool-down has passed.
+	CircuitOpen
+	// CircuitHalfOpen lets a single probe request through, whose outcome
+	// closes or reopens the circuit.
+	CircuitHalfOpen
+)
+
+var circuitStateNames = map[CircuitState]string{
+	CircuitClosed:   "closed",
+	CircuitOpen:     "open",
+	CircuitHalfOpen: "half-open",
+}
+
+// String implements fmt.Stringer.
+func (s CircuitState) String() string {
+	return circuitStateNames[s]
+}
+
+// CircuitBreaker stops requests to DigiKey after consecutive failures, so
+// that while the API is down bulk jobs fail fast instead of spending quota
+// and time on requests that are bound to fail. Network errors and 5xx
+// responses are failures; other responses, including 429 Too Many
+// Requests, are not. After the cool-down a single probe request is let
+// through, and the breaker closes if it succeeds.
+type CircuitBreaker struct {
+	mu        sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	state     CircuitState
+	failures  int
+	openedAt  time.Time
+	probing   bool
+	now       func() time.Time
+}
+
+// NewCircuitBreaker creates a circuit breaker that opens after threshold
+// consecutive failures and probes the API again after the cool-down.
+func NewCircuitBreaker(threshold int, cooldown time.Duration) *CircuitBreaker {
+	return &CircuitBreaker{
+		threshold: max(threshold, 1),
+		cooldown:  cooldown,
+		now:       time.Now,
+	}
+}
+
+// WithCircuitBreaker guards the client's requests with the circuit
+// breaker, which may be shared by several clients. Requests made while it
+// is open fail with ErrCircuitOpen without being sent.
+func WithCircuitBreaker(b *CircuitBreaker) ClientOption {
+	return func(client *Client) {
+		client.breaker = b
+	}
+}
+
+// State returns the state of the breaker.
+func (b *CircuitBreaker) State() CircuitState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.state == CircuitOpen && b.now().Sub(b.openedAt) >= b.cooldown {
+		return CircuitHalfOpen
+	}
+	return b.state
+}
+
+// Reset closes the breaker and clears its failure count.
+func (b *CircuitBreaker) Reset() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.state, b.failures, b.probing = CircuitClosed, 0, false
+}
+
+// allow reports whether a request may be sent, returning ErrCircuitOpen if
+// not, and whether the request is the probe of a half-open breaker. Once
+// the cool-down has passed, only one probe is in flight at a time.
+func (b *CircuitBreaker) allow() (probe bool, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.state {
+	case CircuitOpen:
+		if b.now().Sub(b.openedAt) < b.cooldown {
+			return false, ErrCircuitOpen
+		}
+		b.state = CircuitHalfOpen
+		fallthrough
+	case CircuitHalfOpen:
+		if b.probing {
+			return false, ErrCircuitOpen
+		}
+		b.probing = true
+		return true, nil
+	}
+	return false, nil
+}
+
+// record records the outcome of a request allowed by allow, given whether
+// it was the probe. Requests that were never sent or were abandoned by the
+// caller say nothing of the API, so they neither count as failures nor
+// close the circuit. Once the circuit has opened, only the probe decides
+// whether it closes; requests sent before it opened are ignored.
+func (b *CircuitBreaker) record(probe bool, resp *http.Response, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if probe {
+		b.probing = false
+	} else if b.state != CircuitClosed {
+		return
+	}
+	switch {
+	case errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded):
+		return
+	case err == nil && resp.StatusCode < 500:
+		b.state, b.failures = CircuitClosed, 0
+		return
+	}
+	b.failures++
+	if probe || b.failures >= b.threshold {
+		b.state, b.openedAt = CircuitOpen, b.now()
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+var (
+	okResponse     = &http.Response{StatusCode: http.StatusOK}
+	failedResponse = &http.Response{StatusCode: http.StatusServiceUnavailable}
+)
+
+// newTestBreaker returns an open breaker whose clock is advanced by the
+// returned function.
+func newTestBreaker(t *testing.T) (*CircuitBreaker, func(time.Duration)) {
+	t.Helper()
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	b := NewCircuitBreaker(2, time.Minute)
+	b.now = func() time.Time { return now }
+	for range 2 {
+		if _, err := b.allow(); err != nil {
+			t.Fatal(err)
+		}
+		b.record(false, failedResponse, nil)
+	}
+	if b.State() != CircuitOpen {
+		t.Fatalf("State() after 2 failures = %v, want open", b.State())
+	}
+	if _, err := b.allow(); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("allow() while open = %v, want ErrCircuitOpen", err)
+	}
+	return b, func(d time.Duration) { now = now.Add(d) }
+}
+
+func TestCircuitBreakerProbe(t *testing.T) {
+	b, advance := newTestBreaker(t)
+	advance(time.Minute)
+	if b.State() != CircuitHalfOpen {
+		t.Fatalf("State() after the cool-down = %v, want half-open", b.State())
+	}
+	probe, err := b.allow()
+	if err != nil || !probe {
+		t.Fatalf("allow() after the cool-down = %v, %v, want the probe", probe, err)
+	}
+	if _, err := b.allow(); !errors.Is(err, ErrCircuitOpen) {
+		t.Errorf("second allow() while probing = %v, want ErrCircuitOpen", err)
+	}
+
+	// A request sent before the circuit opened completing neither ends the
+	// probe nor closes the circuit.
+	b.record(false, okResponse, nil)
+	if _, err := b.allow(); !errors.Is(err, ErrCircuitOpen) || b.State() != CircuitHalfOpen {
+		t.Errorf("allow() after a stale request = %v in state %v, want ErrCircuitOpen while half-open", err, b.State())
+	}
+
+	b.record(true, okResponse, nil)
+	if b.State() != CircuitClosed {
+		t.Errorf("State() after a successful probe = %v, want closed", b.State())
+	}
+	if probe, err := b.allow(); err != nil || probe {
+		t.Errorf("allow() while closed = %v, %v, want an ordinary request", probe, err)
+	}
+}
+
+func TestCircuitBreakerFailedProbe(t *testing.T) {
+	b, advance := newTestBreaker(t)
+	advance(time.Minute)
+
+	// A probe abandoned by its caller frees the way for another.
+	probe, _ := b.allow()
+	b.record(probe, nil, context.Canceled)
+	if probe, err := b.allow(); err != nil || !probe {
+		t.Fatalf("allow() after a cancelled probe = %v, %v, want a new probe", probe, err)
+	}
+
+	b.record(true, failedResponse, nil)
+	if b.State() != CircuitOpen {
+		t.Errorf("State() after a failed probe = %v, want open", b.State())
+	}
+	advance(59 * time.Second)
+	if _, err := b.allow(); !errors.Is(err, ErrCircuitOpen) {
+		t.Errorf("allow() before the new cool-down = %v, want ErrCircuitOpen", err)
+	}
+}
@@ -0,0 +1,351 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultCacheTTL is how long cached responses are used when WithCache is
+// given a zero TTL.
+const DefaultCacheTTL = time.Hour
+
+// ErrCacheMiss is returned when a response is not in the cache.
+var ErrCacheMiss = errors.New("cache miss")
+
+// CacheEntry is a cached API response body.
+type CacheEntry struct {
+	Body      []byte
+	StoredAt  time.Time
+	ExpiresAt time.Time
+}
+
+// Cache stores API responses. Get returns ErrCacheMiss when the key is not
+// present. Implementations must be safe for concurrent use and should keep
+// entries at least until they expire. Persistent implementations can use a
+// Codec to serialize entries, such as cache/bolt's WithCodec.
+type Cache interface {
+	Get(ctx context.Context, key string) (*CacheEntry, error)
+	Set(ctx context.Context, key string, entry *CacheEntry) error
+	Delete(ctx context.Context, key string) error
+}
+
+// WithCache caches successful API responses in cache for ttl, or for
+// DefaultCacheTTL if ttl is zero.
+func WithCache(cache Cache, ttl time.Duration) ClientOption {
+	return func(client *Client) {
+		if ttl <= 0 {
+			ttl = DefaultCacheTTL
+		}
+		client.cache = cache
+		client.cacheTTL = ttl
+	}
+}
+
+// WithStaleWhileRevalidate serves a cached response that expired less than
+// window ago at once, as if it were fresh, and refreshes it in the
+// background, so interactive tools stay responsive while the cache catches
+// up. Concurrent requests for the same response share one refresh. Only
+// requests using the cache normally are served stale; CacheOnly requests
+// still miss on expired responses. The cache must keep entries for the
+// window past their expiry.
+func WithStaleWhileRevalidate(window time.Duration) ClientOption {
+	return func(client *Client) {
+		client.staleWindow = window
+	}
+}
+
+// cacheLookup returns the cache key of the request and, if the request
+// options allow it, the cached response body. The key is empty when the
+// response must not be cached. stale reports that the body expired within
+// the stale-while-revalidate window, so it should be refreshed.
+func (c *Client) cacheLookup(ctx context.Context, req *http.Request, o *requestOptions) (key string, body []byte, stale bool, err error) {
+	if c.cache == nil || o.cacheMode == cacheBypass {
+		if o.cacheMode == cacheOnly {
+			return "", nil, false, ErrCacheMiss
+		}
+		return "", nil, false, nil
+	}
+	key, err = cacheKey(req)
+	if err != nil {
+		return "", nil, false, err
+	}
+	if o.cacheMode == cacheRefresh {
+		return key, nil, false, nil
+	}
+	entry, err := c.cache.Get(ctx, key)
+	now := time.Now()
+	switch {
+	case err == nil && now.Before(entry.ExpiresAt):
+		c.cacheStats.hits.Add(1)
+		return key, entry.Body, false, nil
+	case err == nil && o.cacheMode == cacheDefault && now.Before(entry.ExpiresAt.Add(c.staleWindow)):
+		c.cacheStats.hits.Add(1)
+		c.cacheStats.stale.Add(1)
+		return key, entry.Body, true, nil
+	case err == nil:
+		c.cacheStats.expired.Add(1)
+	case !errors.Is(err, ErrCacheMiss):
+		c.cacheStats.errors.Add(1)
+	}
+	c.cacheStats.misses.Add(1)
+	if o.cacheMode == cacheOnly {
+		return key, nil, false, ErrCacheMiss
+	}
+	return key, nil, false, nil
+}
+
+// revalidate refreshes the cached response to the request in the
+// background, unless a refresh of it is already running. The request must
+// not have been sent.
+func (c *Client) revalidate(ctx context.Context, key string, req *http.Request, o *requestOptions) {
+	if _, running := c.revalidating.LoadOrStore(key, struct{}{}); running {
+		return
+	}
+	// The caller has its response, so the refresh must outlive its
+	// context and must not report to its metadata.
+	ro := *o
+	ro.metadata = nil
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		defer c.revalidating.Delete(key)
+		if ro.timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, ro.timeout)
+			defer cancel()
+		}
+		if body, err := c.send(ctx, req, &ro); err == nil {
+			c.cacheStore(ctx, key, body)
+		}
+	}()
+}
+
+// cacheStore stores the response body under key. Caching is best effort, so
+// errors from the cache are ignored.
+func (c *Client) cacheStore(ctx context.Context, key string, body []byte) {
+	if key == "" {
+		return
+	}
+	now := time.Now()
+	err := c.cache.Set(ctx, key, &CacheEntry{
+		Body:      body,
+		StoredAt:  now,
+		ExpiresAt: now.Add(c.cacheTTL),
+	})
+	if err != nil {
+		c.cacheStats.errors.Add(1)
+		return
+	}
+	c.cacheStats.stores.Add(1)
+}
+
+// CacheStats counts the lookups and changes the client made to its cache,
+// e.g. to estimate the quota the cache saves: every hit is a request not
+// sent.
+type CacheStats struct {
+	// Hits is the number of responses served from the cache, including
+	// Stale.
+	Hits int64
+	// Stale is the number of expired responses served while they were
+	// refreshed, with WithStaleWhileRevalidate.
+	Stale int64
+	// Misses is the number of lookups that found no fresh response,
+	// including those that found an expired one.
+	Misses int64
+	// Expired is the number of misses that found an expired response.
+	Expired int64
+	// Stores is the number of responses stored.
+	Stores int64
+	// Evictions is the number of responses removed by InvalidateAll plus
+	// the number of keys invalidated by Invalidate and InvalidateProduct,
+	// whether or not they were cached.
+	Evictions int64
+	// Errors is the number of cache operations that failed. The client
+	// treats a failed lookup as a miss and ignores a failed store.
+	Errors int64
+}
+
+// HitRate returns the share of lookups served from the cache, or 0 if
+// there were none.
+func (s CacheStats) HitRate() float64 {
+	if s.Hits+s.Misses == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(s.Hits+s.Misses)
+}
+
+// cacheCounters are the counters behind CacheStats.
+type cacheCounters struct {
+	hits, stale, misses, expired, stores, evictions, errors atomic.Int64
+}
+
+// CacheStats returns the counts of the client's cache lookups and changes
+// since the client was created. Requests that bypass the cache are not
+// counted.
+func (c *Client) CacheStats() CacheStats {
+	s := &c.cacheStats
+	return CacheStats{
+		Hits:      s.hits.Load(),
+		Stale:     s.stale.Load(),
+		Misses:    s.misses.Load(),
+		Expired:   s.expired.Load(),
+		Stores:    s.stores.Load(),
+		Evictions: s.evictions.Load(),
+		Errors:    s.errors.Load(),
+	}
+}
+
+// ErrCacheNotClearable is returned by InvalidateAll when the client's cache
+// does not implement CacheClearer.
+var ErrCacheNotClearable = errors.New("cache cannot be cleared")
+
+// CacheClearer is implemented by caches that can remove all their entries.
+type CacheClearer interface {
+	// Clear removes every entry and returns how many were removed.
+	Clear(ctx context.Context) (int, error)
+}
+
+// Invalidate removes the response with the given cache key, as reported by
+// ResponseMetadata.CacheKey, from the client's cache. It does nothing if
+// the client has no cache.
+func (c *Client) Invalidate(ctx context.Context, key string) error {
+	if c.cache == nil {
+		return nil
+	}
+	if err := c.cache.Delete(ctx, key); err != nil {
+		c.cacheStats.errors.Add(1)
+		return fmt.Errorf("error invalidating cache entry: %w", err)
+	}
+	c.cacheStats.evictions.Add(1)
+	return nil
+}
+
+// InvalidateProduct removes the cached details of the product with the
+// given DigiKey or manufacturer product number, e.g. after a product change
+// notification. The request options must set the same locale as the
+// request that cached the details, since the locale is part of the key.
+func (c *Client) InvalidateProduct(ctx context.Context, partNumber string, opts ...RequestOption) error {
+	u, err := c.url(productPath(partNumber, "productdetails"), nil)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return err
+	}
+	for k, v := range newRequestOptions(opts).header {
+		req.Header[k] = v
+	}
+	key, err := cacheKey(req)
+	if err != nil {
+		return err
+	}
+	return c.Invalidate(ctx, key)
+}
+
+// InvalidateAll removes every response from the client's cache, returning
+// ErrCacheNotClearable if the cache does not implement CacheClearer. It does
+// nothing if the client has no cache.
+func (c *Client) InvalidateAll(ctx context.Context) error {
+	if c.cache == nil {
+		return nil
+	}
+	cl, ok := c.cache.(CacheClearer)
+	if !ok {
+		return ErrCacheNotClearable
+	}
+	n, err := cl.Clear(ctx)
+	c.cacheStats.evictions.Add(int64(n))
+	if err != nil {
+		c.cacheStats.errors.Add(1)
+		return fmt.Errorf("error clearing cache: %w", err)
+	}
+	return nil
+}
+
+// cacheKey returns a key identifying the request by its method, URL,
+// headers, and body. The authorization headers are added when the request is
+// sent, so they are not part of the key, but headers set by request options,
+// such as the locale, are.
+func cacheKey(req *http.Request) (string, error) {
+	h := sha256.New()
+	io.WriteString(h, req.Method)
+	io.WriteString(h, " ")
+	io.WriteString(h, req.URL.String())
+	for _, k := range slices.Sorted(maps.Keys(req.Header)) {
+		fmt.Fprintf(h, "\n%s: %s", k, strings.Join(req.Header[k], ", "))
+	}
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return "", err
+		}
+		defer body.Close()
+		if _, err := io.Copy(h, body); err != nil {
+			return "", err
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// MemoryCache is an in-memory Cache.
+type MemoryCache struct {
+	mu      sync.Mutex
+	entries map[string]*CacheEntry
+}
+
+// NewMemoryCache creates an empty in-memory cache.
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{entries: make(map[string]*CacheEntry)}
+}
+
+// Get implements Cache.
+func (m *MemoryCache) Get(_ context.Context, key string) (*CacheEntry, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	entry, ok := m.entries[key]
+	if !ok {
+		return nil, ErrCacheMiss
+	}
+	return entry, nil
+}
+
+// Set implements Cache.
+func (m *MemoryCache) Set(_ context.Context, key string, entry *CacheEntry) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries[key] = entry
+	return nil
+}
+
+// Delete implements Cache.
+func (m *MemoryCache) Delete(_ context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.entries, key)
+	return nil
+}
+
+// Clear implements CacheClearer.
+func (m *MemoryCache) Clear(_ context.Context) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	n := len(m.entries)
+	clear(m.entries)
+	return n, nil
+}
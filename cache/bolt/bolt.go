@@ -0,0 +1,203 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+// Package bolt is a digikey.Cache persisting API responses in a bbolt
+// database, an embedded pure-Go key-value store kept in a single file, for
+// programs that cannot use SQLite or a cache server.
+//
+//	cache, err := bolt.Open("digikey-cache.db")
+//	...
+//	defer cache.Close()
+//	c, err := digikey.NewClient(id, secret, digikey.WithCache(cache, 24*time.Hour))
+package bolt
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/apidepot/digikey"
+	bolt "go.etcd.io/bbolt"
+)
+
+// Bucket names and the key of the codec name in the meta bucket.
+var (
+	entriesBucket = []byte("digikey_cache")
+	metaBucket    = []byte("digikey_cache_meta")
+	codecKey      = []byte("codec")
+)
+
+// ErrCodecMismatch is returned when opening a database whose entries were
+// written with a different codec.
+var ErrCodecMismatch = errors.New("cache was written with a different codec")
+
+// Cache is a digikey.Cache storing responses in a bbolt database. Each
+// entry is stored as its expiry time followed by the entry serialized with
+// the cache's codec, so expired entries are found without decoding them.
+type Cache struct {
+	db    *bolt.DB
+	codec digikey.Codec
+	owned bool
+}
+
+// Option applies an option to a cache.
+type Option func(*Cache)
+
+// WithCodec sets the codec serializing entries, by default
+// digikey.GobCodec, or e.g. digikeypb.Codec. A database keeps the codec it
+// was created with.
+func WithCodec(codec digikey.Codec) Option {
+	return func(c *Cache) {
+		c.codec = codec
+	}
+}
+
+// Open opens or creates the database file at path and returns a cache
+// storing responses in it. Close the cache to close the file.
+func Open(path string, opts ...Option) (*Cache, error) {
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("error opening cache: %w", err)
+	}
+	c, err := New(db, opts...)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	c.owned = true
+	return c, nil
+}
+
+// New returns a cache storing responses in buckets of an open database,
+// which may hold other buckets too. Closing the cache leaves the database
+// open.
+func New(db *bolt.DB, opts ...Option) (*Cache, error) {
+	c := &Cache{db: db, codec: digikey.GobCodec}
+	for _, opt := range opts {
+		opt(c)
+	}
+	err := db.Update(func(tx *bolt.Tx) error {
+		if _, err := tx.CreateBucketIfNotExists(entriesBucket); err != nil {
+			return err
+		}
+		meta, err := tx.CreateBucketIfNotExists(metaBucket)
+		if err != nil {
+			return err
+		}
+		name := meta.Get(codecKey)
+		if name == nil {
+			return meta.Put(codecKey, []byte(c.codec.Name()))
+		}
+		if string(name) != c.codec.Name() {
+			return fmt.Errorf("%w: %s, not %s", ErrCodecMismatch, name, c.codec.Name())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error opening cache: %w", err)
+	}
+	return c, nil
+}
+
+// Close closes the database if the cache opened it.
+func (c *Cache) Close() error {
+	if !c.owned {
+		return nil
+	}
+	return c.db.Close()
+}
+
+// Get implements digikey.Cache.
+func (c *Cache) Get(_ context.Context, key string) (*digikey.CacheEntry, error) {
+	var data []byte
+	err := c.db.View(func(tx *bolt.Tx) error {
+		v := tx.Bucket(entriesBucket).Get([]byte(key))
+		if v == nil {
+			return digikey.ErrCacheMiss
+		}
+		// The value is only valid during the transaction.
+		data = append([]byte(nil), v...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < 8 {
+		return nil, fmt.Errorf("error decoding cache entry %s: truncated", key)
+	}
+	entry := &digikey.CacheEntry{}
+	if err := c.codec.Unmarshal(data[8:], entry); err != nil {
+		return nil, fmt.Errorf("error decoding cache entry %s: %w", key, err)
+	}
+	return entry, nil
+}
+
+// Set implements digikey.Cache.
+func (c *Cache) Set(_ context.Context, key string, entry *digikey.CacheEntry) error {
+	data, err := c.codec.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("error encoding cache entry %s: %w", key, err)
+	}
+	v := binary.BigEndian.AppendUint64(make([]byte, 0, 8+len(data)), uint64(entry.ExpiresAt.UnixNano()))
+	v = append(v, data...)
+	return c.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(entriesBucket).Put([]byte(key), v)
+	})
+}
+
+// Delete implements digikey.Cache.
+func (c *Cache) Delete(_ context.Context, key string) error {
+	return c.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(entriesBucket).Delete([]byte(key))
+	})
+}
+
+// Clear implements digikey.CacheClearer.
+func (c *Cache) Clear(_ context.Context) (int, error) {
+	n := 0
+	err := c.db.Update(func(tx *bolt.Tx) error {
+		n = tx.Bucket(entriesBucket).Stats().KeyN
+		if err := tx.DeleteBucket(entriesBucket); err != nil {
+			return err
+		}
+		_, err := tx.CreateBucket(entriesBucket)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// Prune deletes the entries that expired before now and returns how many
+// were deleted.
+func (c *Cache) Prune(_ context.Context, now time.Time) (int64, error) {
+	var n int64
+	err := c.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(entriesBucket)
+		// Collect the keys first, since deleting under a cursor can skip
+		// entries.
+		var expired [][]byte
+		err := b.ForEach(func(k, v []byte) error {
+			if len(v) < 8 || int64(binary.BigEndian.Uint64(v)) < now.UnixNano() {
+				expired = append(expired, append([]byte(nil), k...))
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		for _, k := range expired {
+			if err := b.Delete(k); err != nil {
+				return err
+			}
+		}
+		n = int64(len(expired))
+		return nil
+	})
+	return n, err
+}
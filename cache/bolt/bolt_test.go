@@ -0,0 +1,132 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package bolt_test
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/apidepot/digikey"
+	"github.com/apidepot/digikey/cache/bolt"
+	"github.com/apidepot/digikey/digikeytest"
+)
+
+const detailsPath = "/products/v4/search/296-1395-1-ND/productdetails"
+
+func openCache(t *testing.T, path string, opts ...bolt.Option) *bolt.Cache {
+	t.Helper()
+	c, err := bolt.Open(path, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { c.Close() })
+	return c
+}
+
+func TestCache(t *testing.T) {
+	ctx := context.Background()
+	c := openCache(t, filepath.Join(t.TempDir(), "cache.db"))
+	now := time.Now().Round(0)
+
+	if _, err := c.Get(ctx, "a"); !errors.Is(err, digikey.ErrCacheMiss) {
+		t.Errorf("Get() of a missing key = %v, want ErrCacheMiss", err)
+	}
+	for _, key := range []string{"a", "b", "c"} {
+		entry := &digikey.CacheEntry{Body: []byte(key), StoredAt: now, ExpiresAt: now.Add(time.Hour)}
+		if err := c.Set(ctx, key, entry); err != nil {
+			t.Fatal(err)
+		}
+	}
+	entry, err := c.Get(ctx, "a")
+	if err != nil || string(entry.Body) != "a" || !entry.ExpiresAt.Equal(now.Add(time.Hour)) {
+		t.Errorf("Get() = %+v, %v, want the entry set", entry, err)
+	}
+
+	if err := c.Delete(ctx, "a"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Get(ctx, "a"); !errors.Is(err, digikey.ErrCacheMiss) {
+		t.Errorf("Get() after Delete = %v, want ErrCacheMiss", err)
+	}
+	if n, err := c.Clear(ctx); err != nil || n != 2 {
+		t.Errorf("Clear() = %d, %v, want 2", n, err)
+	}
+	if _, err := c.Get(ctx, "b"); !errors.Is(err, digikey.ErrCacheMiss) {
+		t.Errorf("Get() after Clear = %v, want ErrCacheMiss", err)
+	}
+}
+
+func TestCachePrune(t *testing.T) {
+	ctx := context.Background()
+	c := openCache(t, filepath.Join(t.TempDir(), "cache.db"))
+	now := time.Now()
+	c.Set(ctx, "expired", &digikey.CacheEntry{Body: []byte("x"), ExpiresAt: now.Add(-time.Minute)})
+	c.Set(ctx, "fresh", &digikey.CacheEntry{Body: []byte("y"), ExpiresAt: now.Add(time.Minute)})
+
+	if n, err := c.Prune(ctx, now); err != nil || n != 1 {
+		t.Errorf("Prune() = %d, %v, want 1", n, err)
+	}
+	if _, err := c.Get(ctx, "expired"); !errors.Is(err, digikey.ErrCacheMiss) {
+		t.Errorf("Get() of a pruned entry = %v, want ErrCacheMiss", err)
+	}
+	if _, err := c.Get(ctx, "fresh"); err != nil {
+		t.Errorf("Get() of a fresh entry = %v", err)
+	}
+}
+
+func TestCacheTTL(t *testing.T) {
+	srv := digikeytest.NewServer("id", "secret")
+	defer srv.Close()
+	client, err := srv.NewClient("id", "secret", digikey.WithCache(openCache(t, filepath.Join(t.TempDir(), "cache.db")), 50*time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	for range 2 {
+		if _, err := client.Products.ProductDetails(ctx, "296-1395-1-ND"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	srv.AssertRequested(t, "GET", detailsPath, 1)
+
+	time.Sleep(100 * time.Millisecond)
+	if _, err := client.Products.ProductDetails(ctx, "296-1395-1-ND"); err != nil {
+		t.Fatal(err)
+	}
+	srv.AssertRequested(t, "GET", detailsPath, 2)
+}
+
+func TestCacheReopen(t *testing.T) {
+	srv := digikeytest.NewServer("id", "secret")
+	defer srv.Close()
+	path := filepath.Join(t.TempDir(), "cache.db")
+	ctx := context.Background()
+
+	for range 2 {
+		c, err := bolt.Open(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		client, err := srv.NewClient("id", "secret", digikey.WithCache(c, time.Hour))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := client.Products.ProductDetails(ctx, "296-1395-1-ND"); err != nil {
+			t.Fatal(err)
+		}
+		if err := c.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	srv.AssertRequested(t, "GET", detailsPath, 1)
+
+	if _, err := bolt.Open(path, bolt.WithCodec(digikey.JSONCodec)); !errors.Is(err, bolt.ErrCodecMismatch) {
+		t.Errorf("Open() with another codec = %v, want ErrCodecMismatch", err)
+	}
+}
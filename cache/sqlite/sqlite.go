@@ -0,0 +1,240 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+// Package sqlite is a digikey.Cache persisting API responses in an SQLite
+// database, so they survive restarts and can be shared by the tools of a
+// workstation or CI runner.
+//
+// Product detail responses are also indexed by DigiKey and manufacturer
+// product number, so products can be looked up without a request, even
+// after their entries expire, e.g. to price a BOM offline.
+//
+// The module does not depend on an SQLite driver, so the program must
+// import and open one: modernc.org/sqlite, in pure Go, registered as
+// "sqlite", or github.com/mattn/go-sqlite3, using cgo, registered as
+// "sqlite3". Any other database/sql driver works if its SQLite is 3.24 or
+// later, which Set's upsert requires. For example:
+//
+//	import _ "modernc.org/sqlite"
+//
+//	db, err := sql.Open("sqlite", "digikey-cache.db")
+//	...
+//	cache, err := sqlite.New(ctx, db)
+//	...
+//	c, err := digikey.NewClient(id, secret, digikey.WithCache(cache, 24*time.Hour))
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/apidepot/digikey"
+	"github.com/apidepot/digikey/internal/currency"
+)
+
+// schema creates the tables of the cache if they do not exist.
+const schema = `
+CREATE TABLE IF NOT EXISTS digikey_cache (
+	key        TEXT PRIMARY KEY,
+	body       BLOB NOT NULL,
+	stored_at  INTEGER NOT NULL,
+	expires_at INTEGER NOT NULL
+);
+CREATE INDEX IF NOT EXISTS digikey_cache_expires_at ON digikey_cache (expires_at);
+CREATE TABLE IF NOT EXISTS digikey_part_numbers (
+	part_number TEXT NOT NULL,
+	key         TEXT NOT NULL,
+	PRIMARY KEY (part_number, key)
+);
+CREATE INDEX IF NOT EXISTS digikey_part_numbers_key ON digikey_part_numbers (key);
+`
+
+// Cache is a digikey.Cache storing responses in an SQLite database.
+type Cache struct {
+	db *sql.DB
+}
+
+// New returns a cache storing responses in the database, creating its
+// tables if needed. The tables are prefixed with digikey_, so the database
+// may be shared with other data.
+func New(ctx context.Context, db *sql.DB) (*Cache, error) {
+	if _, err := db.ExecContext(ctx, schema); err != nil {
+		return nil, fmt.Errorf("error creating cache tables: %w", err)
+	}
+	return &Cache{db: db}, nil
+}
+
+// Get implements digikey.Cache.
+func (c *Cache) Get(ctx context.Context, key string) (*digikey.CacheEntry, error) {
+	var body []byte
+	var storedAt, expiresAt int64
+	err := c.db.QueryRowContext(ctx,
+		`SELECT body, stored_at, expires_at FROM digikey_cache WHERE key = ?`, key,
+	).Scan(&body, &storedAt, &expiresAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, digikey.ErrCacheMiss
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &digikey.CacheEntry{
+		Body:      body,
+		StoredAt:  time.Unix(0, storedAt),
+		ExpiresAt: time.Unix(0, expiresAt),
+	}, nil
+}
+
+// Set implements digikey.Cache. A product detail response is also indexed
+// by the product's part numbers.
+func (c *Cache) Set(ctx context.Context, key string, entry *digikey.CacheEntry) error {
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO digikey_cache (key, body, stored_at, expires_at) VALUES (?, ?, ?, ?)
+		ON CONFLICT (key) DO UPDATE SET body = excluded.body, stored_at = excluded.stored_at, expires_at = excluded.expires_at`,
+		key, entry.Body, entry.StoredAt.UnixNano(), entry.ExpiresAt.UnixNano())
+	if err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM digikey_part_numbers WHERE key = ?`, key); err != nil {
+		return err
+	}
+	for _, pn := range partNumbers(entry.Body) {
+		_, err := tx.ExecContext(ctx,
+			`INSERT OR IGNORE INTO digikey_part_numbers (part_number, key) VALUES (?, ?)`, pn, key)
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// Delete implements digikey.Cache.
+func (c *Cache) Delete(ctx context.Context, key string) error {
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if _, err := tx.ExecContext(ctx, `DELETE FROM digikey_cache WHERE key = ?`, key); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM digikey_part_numbers WHERE key = ?`, key); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// Clear implements digikey.CacheClearer.
+func (c *Cache) Clear(ctx context.Context) (int, error) {
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+	if _, err := tx.ExecContext(ctx, `DELETE FROM digikey_part_numbers`); err != nil {
+		return 0, err
+	}
+	res, err := tx.ExecContext(ctx, `DELETE FROM digikey_cache`)
+	if err != nil {
+		return 0, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return int(n), tx.Commit()
+}
+
+// Product returns the most recently cached details of the product with the
+// given DigiKey or manufacturer product number, ignoring case, and when
+// they were stored. Expired entries are used too, so check the time if
+// freshness matters. Prices are in the currency of the cached response's
+// locale, as the client would return them. It returns digikey.ErrCacheMiss
+// if the product's details are not cached.
+func (c *Cache) Product(ctx context.Context, partNumber string) (*digikey.Product, time.Time, error) {
+	var body []byte
+	var storedAt int64
+	err := c.db.QueryRowContext(ctx,
+		`SELECT c.body, c.stored_at FROM digikey_part_numbers p
+		JOIN digikey_cache c ON c.key = p.key
+		WHERE p.part_number = ?
+		ORDER BY c.stored_at DESC LIMIT 1`, normalize(partNumber),
+	).Scan(&body, &storedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, time.Time{}, digikey.ErrCacheMiss
+	}
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	var resp detailsResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, time.Time{}, fmt.Errorf("error decoding cached product %s: %w", partNumber, err)
+	}
+	currency.Fill(&resp, digikey.DefaultCurrency)
+	return resp.Product, time.Unix(0, storedAt), nil
+}
+
+// Prune deletes the entries that expired before now and returns how many
+// were deleted.
+func (c *Cache) Prune(ctx context.Context, now time.Time) (int64, error) {
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+	_, err = tx.ExecContext(ctx,
+		`DELETE FROM digikey_part_numbers WHERE key IN
+		(SELECT key FROM digikey_cache WHERE expires_at < ?)`, now.UnixNano())
+	if err != nil {
+		return 0, err
+	}
+	res, err := tx.ExecContext(ctx, `DELETE FROM digikey_cache WHERE expires_at < ?`, now.UnixNano())
+	if err != nil {
+		return 0, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return n, tx.Commit()
+}
+
+// detailsResponse is the part of a product details response the cache
+// indexes and serves.
+type detailsResponse struct {
+	Product          *digikey.Product `json:"Product"`
+	SearchLocaleUsed digikey.Locale   `json:"SearchLocaleUsed"`
+}
+
+// partNumbers returns the normalized part numbers of the product in a
+// product details response body, or nil if the body is not one.
+func partNumbers(body []byte) []string {
+	var resp detailsResponse
+	if json.Unmarshal(body, &resp) != nil || resp.Product == nil || resp.Product.ManufacturerProductNumber == "" {
+		return nil
+	}
+	p := resp.Product
+	pns := []string{normalize(p.ManufacturerProductNumber)}
+	for _, v := range p.ProductVariations {
+		if v.DigiKeyProductNumber != "" {
+			pns = append(pns, normalize(v.DigiKeyProductNumber))
+		}
+	}
+	return pns
+}
+
+// normalize returns the part number in the form it is indexed under.
+func normalize(pn string) string {
+	return strings.ToUpper(strings.TrimSpace(pn))
+}
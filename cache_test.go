@@ -0,0 +1,227 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// versionServer answers each request with the number of requests it has
+// received, after waiting for release if it is not nil.
+type versionServer struct {
+	calls   atomic.Int32
+	release chan struct{}
+}
+
+func (s *versionServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	n := s.calls.Add(1)
+	if s.release != nil {
+		<-s.release
+	}
+	w.Write([]byte(strconv.Itoa(int(n))))
+}
+
+// getVersion returns the body of a cached GET request.
+func getVersion(t *testing.T, ctx context.Context, c *Client, opts ...RequestOption) string {
+	t.Helper()
+	u, _ := c.url("products/v4/search/manufacturers", nil)
+	body, err := c.getBytes(ctx, u.String(), opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(body)
+}
+
+// waitFor polls until cond holds, failing the test after a second.
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	for deadline := time.Now().Add(time.Second); !cond(); {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestStaleWhileRevalidate(t *testing.T) {
+	vs := &versionServer{}
+	c := newTestClient(t, vs.ServeHTTP, WithCache(NewMemoryCache(), 20*time.Millisecond), WithStaleWhileRevalidate(time.Hour))
+	ctx := context.Background()
+	if v := getVersion(t, ctx, c); v != "1" {
+		t.Fatalf("first response = %s, want 1", v)
+	}
+	time.Sleep(40 * time.Millisecond)
+
+	// An expired response within the window is served and refreshed in
+	// the background.
+	if v := getVersion(t, ctx, c); v != "1" {
+		t.Errorf("stale response = %s, want the cached 1", v)
+	}
+	waitFor(t, "the refresh to be stored", func() bool { return c.CacheStats().Stores == 2 })
+	if v := getVersion(t, ctx, c); v != "2" {
+		t.Errorf("response after the refresh = %s, want 2", v)
+	}
+	if stats := c.CacheStats(); stats.Hits != 2 || stats.Stale != 1 || stats.Misses != 1 || vs.calls.Load() != 2 {
+		t.Errorf("CacheStats() = %+v after %d requests, want 2 hits, 1 stale, 1 miss after 2", stats, vs.calls.Load())
+	}
+
+	// CacheOnly requests do not use stale responses.
+	time.Sleep(40 * time.Millisecond)
+	u, _ := c.url("products/v4/search/manufacturers", nil)
+	if _, err := c.getBytes(ctx, u.String(), CacheOnly()); err != ErrCacheMiss {
+		t.Errorf("CacheOnly request of a stale response = %v, want ErrCacheMiss", err)
+	}
+}
+
+func TestStaleWhileRevalidateShared(t *testing.T) {
+	vs := &versionServer{}
+	c := newTestClient(t, vs.ServeHTTP, WithCache(NewMemoryCache(), 20*time.Millisecond), WithStaleWhileRevalidate(time.Hour))
+	getVersion(t, context.Background(), c)
+	time.Sleep(40 * time.Millisecond)
+
+	// Concurrent stale requests share one refresh, which outlives the
+	// context of the request that started it.
+	vs.release = make(chan struct{})
+	ctx, cancel := context.WithCancel(context.Background())
+	u, _ := c.url("products/v4/search/manufacturers", nil)
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if body, err := c.getBytes(ctx, u.String()); err != nil || string(body) != "1" {
+				t.Errorf("stale response = %s, %v, want the cached 1", body, err)
+			}
+		}()
+	}
+	wg.Wait()
+	cancel()
+	close(vs.release)
+	waitFor(t, "the refresh to be stored", func() bool { return c.CacheStats().Stores == 2 })
+	if n := vs.calls.Load(); n != 2 {
+		t.Errorf("%d refreshes for concurrent stale requests, want 1", n-1)
+	}
+}
+
+func TestStaleWhileRevalidateWindow(t *testing.T) {
+	vs := &versionServer{}
+	c := newTestClient(t, vs.ServeHTTP, WithCache(NewMemoryCache(), 20*time.Millisecond), WithStaleWhileRevalidate(20*time.Millisecond))
+	ctx := context.Background()
+	getVersion(t, ctx, c)
+	time.Sleep(60 * time.Millisecond)
+
+	// Past the window the response is fetched before returning.
+	if v := getVersion(t, ctx, c); v != "2" {
+		t.Errorf("response expired past the window = %s, want 2", v)
+	}
+	if stats := c.CacheStats(); stats.Stale != 0 || stats.Expired != 1 {
+		t.Errorf("CacheStats() = %+v, want 1 expired and none stale", stats)
+	}
+}
+
+// productServer answers product details requests with the part number
+// requested, counting the requests.
+func productServer(calls *atomic.Int32) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		pn := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/products/v4/search/"), "/productdetails")
+		fmt.Fprintf(w, `{"Product":{"ManufacturerProductNumber":%q}}`, pn)
+	}
+}
+
+// failingCache is a cache whose operations fail.
+type failingCache struct{}
+
+func (failingCache) Get(context.Context, string) (*CacheEntry, error) {
+	return nil, errors.New("cache down")
+}
+func (failingCache) Set(context.Context, string, *CacheEntry) error { return errors.New("cache down") }
+func (failingCache) Delete(context.Context, string) error           { return errors.New("cache down") }
+
+func TestCacheStats(t *testing.T) {
+	var calls atomic.Int32
+	c := newTestClient(t, productServer(&calls), WithCache(NewMemoryCache(), time.Hour))
+	ctx := context.Background()
+	for _, pn := range []string{"LM358DR", "LM358DR", "LM358DR", "NE555P"} {
+		if _, err := c.Products.ProductDetails(ctx, pn); err != nil {
+			t.Fatal(err)
+		}
+	}
+	c.Products.ProductDetails(ctx, "LM358DR", BypassCache())
+	want := CacheStats{Hits: 2, Misses: 2, Stores: 2}
+	if stats := c.CacheStats(); stats != want || calls.Load() != 3 {
+		t.Errorf("CacheStats() = %+v after %d requests, want %+v after 3", stats, calls.Load(), want)
+	}
+	if rate := c.CacheStats().HitRate(); rate != 0.5 {
+		t.Errorf("HitRate() = %v, want 0.5", rate)
+	}
+
+	if err := c.InvalidateAll(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if stats := c.CacheStats(); stats.Evictions != 2 {
+		t.Errorf("Evictions after InvalidateAll = %d, want the 2 entries", stats.Evictions)
+	}
+
+	// A failing cache counts errors and misses but does not fail requests.
+	c = newTestClient(t, productServer(&calls), WithCache(failingCache{}, time.Hour))
+	if _, err := c.Products.ProductDetails(ctx, "LM358DR"); err != nil {
+		t.Fatal(err)
+	}
+	if stats := c.CacheStats(); stats.Errors != 2 || stats.Misses != 1 || stats.Stores != 0 {
+		t.Errorf("CacheStats() of a failing cache = %+v, want 2 errors and 1 miss", stats)
+	}
+	if err := c.InvalidateAll(ctx); !errors.Is(err, ErrCacheNotClearable) {
+		t.Errorf("InvalidateAll() = %v, want ErrCacheNotClearable", err)
+	}
+}
+
+func TestInvalidateProduct(t *testing.T) {
+	var calls atomic.Int32
+	c := newTestClient(t, productServer(&calls), WithCache(NewMemoryCache(), time.Hour))
+	ctx := context.Background()
+	euro := WithRequestLocale(Locale{Currency: "EUR"})
+	for range 2 {
+		c.Products.ProductDetails(ctx, "LM358DR", euro)
+		c.Products.ProductDetails(ctx, "NE555P", euro)
+	}
+	if calls.Load() != 2 {
+		t.Fatalf("%d requests before invalidation, want 2", calls.Load())
+	}
+
+	// The locale is part of the key, so invalidating without it misses.
+	if err := c.InvalidateProduct(ctx, "LM358DR"); err != nil {
+		t.Fatal(err)
+	}
+	c.Products.ProductDetails(ctx, "LM358DR", euro)
+	if calls.Load() != 2 {
+		t.Errorf("details requested again after invalidating another locale")
+	}
+
+	if err := c.InvalidateProduct(ctx, "LM358DR", euro); err != nil {
+		t.Fatal(err)
+	}
+	p, err := c.Products.ProductDetails(ctx, "LM358DR", euro)
+	if err != nil || p.ManufacturerProductNumber != "LM358DR" || calls.Load() != 3 {
+		t.Errorf("ProductDetails() after InvalidateProduct = %v, %v after %d requests, want LM358DR requested again", p, err, calls.Load())
+	}
+	c.Products.ProductDetails(ctx, "NE555P", euro)
+	if calls.Load() != 3 {
+		t.Errorf("other products' details were invalidated too")
+	}
+	if stats := c.CacheStats(); stats.Evictions != 2 {
+		t.Errorf("Evictions = %d, want 2", stats.Evictions)
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// CategoryPathSeparator separates the category names of a category path,
+// e.g. "Resistors > Chip Resistor - Surface Mount".
+const CategoryPathSeparator = ">"
+
+// categoriesResponse is the response to a categories request.
+type categoriesResponse struct {
+	ProductCount     int        `json:"ProductCount"`
+	Categories       []Category `json:"Categories"`
+	SearchLocaleUsed Locale     `json:"SearchLocaleUsed"`
+}
+
+// categoryResponse is the response to a category by ID request.
+type categoryResponse struct {
+	Category         Category `json:"Category"`
+	SearchLocaleUsed Locale   `json:"SearchLocaleUsed"`
+}
+
+// Categories returns the top-level product categories. Each category
+// contains its child categories, so the result is the full category tree.
+func (s *ProductsService) Categories(ctx context.Context, opts ...RequestOption) ([]Category, error) {
+	resp := categoriesResponse{}
+	if err := s.client.getJSON(ctx, productsPath+"categories", nil, &resp, opts...); err != nil {
+		return nil, err
+	}
+	return resp.Categories, nil
+}
+
+// CategoryByID returns the category with the given ID and its children.
+func (s *ProductsService) CategoryByID(ctx context.Context, id int, opts ...RequestOption) (*Category, error) {
+	resp := categoryResponse{}
+	endpoint := productsPath + "categories/" + strconv.Itoa(id)
+	if err := s.client.getJSON(ctx, endpoint, nil, &resp, opts...); err != nil {
+		return nil, err
+	}
+	return &resp.Category, nil
+}
+
+// expandSubtrees returns the request with the descendants of the
+// categories in its subtrees added to its category filter.
+func (s *ProductsService) expandSubtrees(ctx context.Context, req KeywordRequest, opts []RequestOption) (KeywordRequest, error) {
+	if len(req.subtrees) == 0 {
+		return req, nil
+	}
+	f := FilterOptionsRequest{}
+	if req.FilterOptionsRequest != nil {
+		f = *req.FilterOptionsRequest
+	}
+	f.CategoryFilter = cloneIDs(f.CategoryFilter)
+	for _, id := range req.subtrees {
+		c, err := s.CategoryByID(ctx, id, opts...)
+		if err != nil {
+			return req, fmt.Errorf("error expanding category %d: %w", id, err)
+		}
+		for _, child := range c.IDs() {
+			fid := FilterID{ID: strconv.Itoa(child)}
+			if !slices.Contains(f.CategoryFilter, fid) {
+				f.CategoryFilter = append(f.CategoryFilter, fid)
+			}
+		}
+	}
+	req.FilterOptionsRequest = &f
+	req.subtrees = nil
+	return req, nil
+}
+
+// WalkCategories calls fn for each category in the trees in depth-first
+// order, along with the names of the category's ancestors and itself. If fn
+// returns false, the children of the category are skipped.
+func WalkCategories(categories []Category, fn func(path []string, c *Category) bool) {
+	walkCategories(categories, nil, fn)
+}
+
+func walkCategories(categories []Category, path []string, fn func([]string, *Category) bool) {
+	for i := range categories {
+		c := &categories[i]
+		p := append(path[:len(path):len(path)], c.Name)
+		if fn(p, c) {
+			walkCategories(c.ChildCategories, p, fn)
+		}
+	}
+}
+
+// FindCategoryByPath returns the category reached by following the names in
+// path, separated by CategoryPathSeparator, from the top-level categories.
+// Names are matched case-insensitively, ignoring surrounding whitespace.
+func FindCategoryByPath(categories []Category, path string) (*Category, bool) {
+	names := strings.Split(path, CategoryPathSeparator)
+	var found *Category
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		found = nil
+		for i := range categories {
+			if strings.EqualFold(categories[i].Name, name) {
+				found = &categories[i]
+				break
+			}
+		}
+		if found == nil {
+			return nil, false
+		}
+		categories = found.ChildCategories
+	}
+	return found, true
+}
+
+// FindCategoryByID returns the category with the given ID anywhere in the
+// trees.
+func FindCategoryByID(categories []Category, id int) (*Category, bool) {
+	var found *Category
+	WalkCategories(categories, func(_ []string, c *Category) bool {
+		if c.CategoryID == id {
+			found = c
+		}
+		return found == nil
+	})
+	return found, found != nil
+}
+
+// FlattenCategories returns every category in the trees in depth-first
+// order.
+func FlattenCategories(categories []Category) []Category {
+	var flat []Category
+	WalkCategories(categories, func(_ []string, c *Category) bool {
+		flat = append(flat, *c)
+		return true
+	})
+	return flat
+}
+
+// IDs returns the ID of the category followed by the IDs of all of its
+// descendants, for use in search category filters.
+func (c Category) IDs() []int {
+	ids := []int{c.CategoryID}
+	WalkCategories(c.ChildCategories, func(_ []string, child *Category) bool {
+		ids = append(ids, child.CategoryID)
+		return true
+	})
+	return ids
+}
@@ -6,13 +6,17 @@
 package digikey
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -20,8 +24,8 @@ import (
 )
 
 const (
-	apiURL          = "https://api.digikey.com/v1/"
-	sandboxURL      = "https://sandbox-api.digikey.com/v1/"
+	apiURL          = "https://api.digikey.com/"
+	sandboxURL      = "https://sandbox-api.digikey.com/"
 	accessTokenURL  = "https://api.digikey.com/v1/oauth2/token"
 	sandboxTokenURL = "https://sandbox-api.digikey.com/v1/oauth2/token"
 	grantType       = "client_credentials"
@@ -29,22 +33,71 @@ const (
 
 // Client models a client to consume the DigiKey API.
 type Client struct {
-	baseURL        string
-	accessTokenURL string
-	id             string
-	secret         string
-	accessToken    string
-	tokenType      string
-	tokenExpiresAt time.Time
-	httpClient     *http.Client
-	rateLimiter    *rate.Limiter
-	mu             sync.RWMutex
+	environment        Environment
+	baseURL            string
+	accessTokenURL     string
+	id                 string
+	secret             string
+	accessToken        string
+	tokenType          string
+	tokenExpiresAt     time.Time
+	tokenLifetime      time.Duration
+	tokenRefreshMargin time.Duration
+	tokenErr           *TokenError
+	tokenBackoff       time.Duration
+	tokenBackoffMax    time.Duration
+	staticToken        bool
+	refreshWindow      time.Duration
+	refresherCancel    context.CancelFunc
+	refresherStopped   chan struct{}
+	closeOnce          sync.Once
+	httpClient         *http.Client
+	apiClient          *http.Client
+	transportOpts      []func(*http.Transport)
+	httpClientOpts     []func(*http.Client)
+	optErr             error
+	userAgent          string
+	rateLimiter        Limiter
+	cache              Cache
+	cacheTTL           time.Duration
+	cacheStats         cacheCounters
+	staleWindow        time.Duration
+	offline            bool
+	revalidating       sync.Map
+	quota              *QuotaTracker
+	breaker            *CircuitBreaker
+	hedging            *hedger
+	maxRetries         int
+	retryBackoff       time.Duration
+	retryBackoffMax    time.Duration
+	concurrency        int
+	packagingPrefs     []PackagingType
+	productsVersion    APIVersion
+	mu                 sync.RWMutex
+
+	// Products provides access to the Product Information V4 API.
+	Products *ProductsService
+
+	// Orders provides access to the Order Status V4 API.
+	Orders *OrdersService
+
+	// Lists provides access to the MyLists API.
+	Lists *ListsService
+
+	// Quotes provides access to the Quoting V4 API.
+	Quotes *QuotesService
+
+	// Barcoding provides access to the Barcoding V3 API.
+	Barcoding *BarcodingService
 }
 
 // Error represents an IEX API error
 type Error struct {
 	StatusCode int
 	Message    string
+	// RetryAfter is the delay the response's Retry-After header asked for,
+	// or zero if it had none.
+	RetryAfter time.Duration
 }
 
 // ClientOption applies an option to the client.
@@ -57,6 +110,13 @@ func (e Error) Error() string {
 
 // NewClient creates a client with the given authorization token.
 func NewClient(id, secret string, opts ...ClientOption) (*Client, error) {
+	return NewClientContext(context.Background(), id, secret, opts...)
+}
+
+// NewClientContext creates a client with the given authorization token,
+// requesting the first access token under the context, so that startup
+// respects the caller's deadline and cancellation.
+func NewClientContext(ctx context.Context, id, secret string, opts ...ClientOption) (*Client, error) {
 	c := &Client{
 		id:             id,
 		secret:         secret,
@@ -64,39 +124,96 @@ func NewClient(id, secret string, opts ...ClientOption) (*Client, error) {
 		tokenExpiresAt: time.Now(),
 
 		// Set default values, which may be overridden by user options.
-		baseURL:        apiURL,
-		accessTokenURL: accessTokenURL,
-		rateLimiter:    rate.NewLimiter(rate.Every(time.Second), 100),
+		baseURL:            apiURL,
+		accessTokenURL:     accessTokenURL,
+		rateLimiter:        rate.NewLimiter(rate.Every(time.Second), 100),
+		concurrency:        DefaultConcurrency,
+		packagingPrefs:     DefaultPackagingPreference,
+		userAgent:          DefaultUserAgent(),
+		tokenRefreshMargin: DefaultTokenRefreshMargin,
+		tokenBackoff:       DefaultTokenBackoff,
+		tokenBackoffMax:    DefaultTokenBackoffMax,
+		maxRetries:         DefaultMaxRetries,
+		retryBackoff:       DefaultRetryBackoff,
+		retryBackoffMax:    DefaultRetryBackoffMax,
 	}
+	c.Products = &ProductsService{client: c}
+	c.Orders = &OrdersService{client: c}
+	c.Lists = &ListsService{client: c}
+	c.Quotes = &QuotesService{client: c}
+	c.Barcoding = &BarcodingService{client: c}
 
 	// Apply options using the functional option pattern.
 	for _, opt := range opts {
 		opt(c)
 	}
+	if c.optErr != nil {
+		return nil, c.optErr
+	}
+	if err := c.configureTransport(); err != nil {
+		return nil, err
+	}
+	c.apiClient = c.authenticatedClient()
 
+	if c.offline {
+		return c, nil
+	}
 	// Get the access token.
-	if _, err := c.getAccessToken(); err != nil {
+	if _, err := c.getAccessToken(ctx); err != nil {
 		return nil, err
 	}
+	if c.refreshWindow > 0 && !c.staticToken {
+		c.startTokenRefresher()
+	}
 
 	return c, nil
 }
 
-// WithSandbox sets the baseURL to the default sandbox URL.
+// WithDefaultSandbox sets the API and token URLs to those of the sandbox.
+// It is equivalent to WithEnvironment(Sandbox).
 func WithDefaultSandbox() ClientOption {
+	return WithEnvironment(Sandbox)
+}
+
+// WithBaseURL sets the baseURL for a new IEX Client. Endpoint paths, such
+// as products/v4/search/keyword, carry their own API version, so a trailing
+// v1/ left from the former default of https://api.digikey.com/v1/ is
+// removed.
+func WithBaseURL(baseURL string) ClientOption {
 	return func(client *Client) {
-		client.baseURL = sandboxURL
-		client.accessTokenURL = sandboxTokenURL
+		client.baseURL = normalizeBaseURL(baseURL)
 	}
 }
 
-// WithBaseURL sets the baseURL for a new IEX Client.
-func WithBaseURL(baseURL string) ClientOption {
+// normalizeBaseURL returns the base URL ending in a slash and without a
+// trailing v1 path segment.
+func normalizeBaseURL(baseURL string) string {
+	baseURL = strings.TrimSuffix(baseURL, "/")
+	baseURL = strings.TrimSuffix(baseURL, "/v1")
+	return baseURL + "/"
+}
+
+// WithTokenURL sets the URL used to request OAuth2 access tokens.
+func WithTokenURL(tokenURL string) ClientOption {
+	return func(client *Client) {
+		client.accessTokenURL = tokenURL
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to send requests, e.g. to use a
+// custom transport.
+func WithHTTPClient(httpClient *http.Client) ClientOption {
 	return func(client *Client) {
-		client.baseURL = baseURL
+		client.httpClient = httpClient
 	}
 }
 
+// Limiter paces the requests of a client. Wait blocks until a request may
+// be sent or the context is done. *rate.Limiter implements Limiter.
+type Limiter interface {
+	Wait(ctx context.Context) error
+}
+
 // WithRateLimiter sets the rate limiter.
 func WithRateLimiter(duration time.Duration, numRequests int) ClientOption {
 	return func(client *Client) {
@@ -104,20 +221,55 @@ func WithRateLimiter(duration time.Duration, numRequests int) ClientOption {
 	}
 }
 
+// WithLimiter sets the limiter that paces requests, e.g. one shared by
+// several clients or a deterministic limiter in tests.
+func WithLimiter(l Limiter) ClientOption {
+	return func(client *Client) {
+		client.rateLimiter = l
+	}
+}
+
+// WithNoRateLimit disables client-side rate limiting, so tests against a
+// fake server don't wait on the token bucket. Requests are still subject
+// to the quota tracker, if any.
+func WithNoRateLimit() ClientOption {
+	return WithLimiter(noLimit{})
+}
+
+// noLimit is a Limiter that never waits.
+type noLimit struct{}
+
+func (noLimit) Wait(ctx context.Context) error {
+	return ctx.Err()
+}
+
 // GetJSON gets the JSON data from the given endpoint.
 func (c *Client) GetJSON(ctx context.Context, endpoint string, v any) error {
-	u, err := c.url(endpoint, map[string]string{"token": c.accessToken})
+	u, err := c.url(endpoint, nil)
 	if err != nil {
 		return err
 	}
 	return c.FetchURLToJSON(ctx, u, v)
 }
 
+// Get gets the JSON data from the given endpoint with the optional query
+// parameters attached and decodes it into a T, for endpoints the package
+// does not wrap, e.g.
+//
+//	resp, err := digikey.Get[MyResponse](ctx, c, "products/v4/search/packagetypes", nil)
+func Get[T any](ctx context.Context, c *Client, endpoint string, params map[string]string, opts ...RequestOption) (T, error) {
+	var v T
+	if err := c.getJSON(ctx, endpoint, params, &v, opts...); err != nil {
+		var zero T
+		return zero, err
+	}
+	return v, nil
+}
+
 // GetJSONWithQueryParams gets the JSON data from the given endpoint with the
 // query parameters attached.
 func (c *Client) GetJSONWithQueryParams(ctx context.Context,
 	endpoint string, queryParams map[string]string, v interface{}) error {
-	queryParams["token"] = c.accessToken
 	u, err := c.url(endpoint, queryParams)
 	if err != nil {
 		return err
@@ -125,17 +277,34 @@ func (c *Client) GetJSONWithQueryParams(ctx context.Context,
 	return c.FetchURLToJSON(ctx, u, v)
 }
 
-// Fetches JSON content from the given URL and unmarshals it into `v`.
+// Fetches JSON content from the given URL and decodes it into `v`. Without a
+// cache, the response is decoded as it is read rather than buffered whole.
 func (c *Client) FetchURLToJSON(ctx context.Context, u *url.URL, v any) error {
-	data, err := c.getBytes(ctx, u.String())
+	if c.cache != nil {
+		data, err := c.getBytes(ctx, u.String())
+		if err != nil {
+			return err
+		}
+		return json.Unmarshal(data, v)
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return err
+	}
+	body, err := c.stream(ctx, req)
 	if err != nil {
 		return err
 	}
-	return json.Unmarshal(data, v)
+	defer body.Close()
+	if err := json.NewDecoder(body).Decode(v); err != nil {
+		return fmt.Errorf("error decoding response body: %w", err)
+	}
+	return nil
 }
 
-// GetJSONWithoutToken gets the JSON data from the given endpoint without
-// adding a token to the URL.
+// GetJSONWithoutToken gets the JSON data from the given endpoint. The token
+// is never added to the URL, since every request carries it in the
+// Authorization header, so it is equivalent to GetJSON.
 func (c *Client) GetJSONWithoutToken(ctx context.Context, endpoint string, v any) error {
 	u, err := c.url(endpoint, nil)
 	if err != nil {
@@ -146,7 +315,7 @@ func (c *Client) GetJSONWithoutToken(ctx context.Context, endpoint string, v any
 
 // GetBytes gets the data from the given endpoint.
 func (c *Client) GetBytes(ctx context.Context, endpoint string) ([]byte, error) {
-	u, err := c.url(endpoint, map[string]string{"token": c.accessToken})
+	u, err := c.url(endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -162,33 +331,407 @@ func (c *Client) GetFloat64(ctx context.Context, endpoint string) (float64, erro
 	return strconv.ParseFloat(string(b), 64)
 }
 
-func (c *Client) getBytes(ctx context.Context, address string) ([]byte, error) {
+func (c *Client) getBytes(ctx context.Context, address string, opts ...RequestOption) ([]byte, error) {
 	req, err := http.NewRequest("GET", address, nil)
 	if err != nil {
 		return []byte{}, err
 	}
-	err = c.rateLimiter.Wait(ctx)
+	return c.do(ctx, req, opts...)
+}
+
+// getJSON gets the JSON data from the given endpoint with the optional query
+// parameters attached and unmarshals it into v.
+func (c *Client) getJSON(ctx context.Context, endpoint string, queryParams map[string]string, v any, opts ...RequestOption) error {
+	u, err := c.url(endpoint, queryParams)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	data, err := c.getBytes(ctx, u.String(), opts...)
+	if err != nil {
+		return err
+	}
+	return unmarshalResponse(data, v, opts)
+}
+
+// getCustomerJSON gets customer data, such as lists, quotes, and orders,
+// like getJSON but never from or into the cache: the data belongs to the
+// token's customer, which the cache key does not include, and changes as
+// the customer acts on it.
+func (c *Client) getCustomerJSON(ctx context.Context, endpoint string, queryParams map[string]string, v any, opts ...RequestOption) error {
+	opts = append(opts[:len(opts):len(opts)], BypassCache())
+	return c.getJSON(ctx, endpoint, queryParams, v, opts...)
+}
+
+// postJSON marshals body to JSON, posts it to the given endpoint, and
+// unmarshals the JSON response into v. It is used for searches, so the
+// response may be cached.
+func (c *Client) postJSON(ctx context.Context, endpoint string, body, v any, opts ...RequestOption) error {
+	return c.doJSON(ctx, "POST", endpoint, body, v, opts...)
+}
+
+// sendJSON sends a request that changes state, such as creating or deleting
+// a list, so its response is never cached. The body is marshaled to JSON
+// unless it is nil, and the response is unmarshaled into v unless v is nil
+// or the response is empty.
+func (c *Client) sendJSON(ctx context.Context, method, endpoint string, body, v any, opts ...RequestOption) error {
+	opts = append(opts[:len(opts):len(opts)], BypassCache())
+	return c.doJSON(ctx, method, endpoint, body, v, opts...)
+}
+
+func (c *Client) doJSON(ctx context.Context, method, endpoint string, body, v any, opts ...RequestOption) error {
+	u, err := c.url(endpoint, nil)
+	if err != nil {
+		return err
+	}
+	var r io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("error marshaling request body: %w", err)
+		}
+		r = bytes.NewReader(data)
+	}
+	req, err := http.NewRequest(method, u.String(), r)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	b, err := c.do(ctx, req, opts...)
+	if err != nil {
+		return err
+	}
+	if v == nil || len(b) == 0 {
+		return nil
+	}
+	return unmarshalResponse(b, v, opts)
+}
+
+// unmarshalResponse unmarshals a JSON response into v and fills in the
+// currency of its prices, which the response states in its locale, if at
+// all, or else is the currency requested.
+func unmarshalResponse(data []byte, v any, opts []RequestOption) error {
+	if err := json.Unmarshal(data, v); err != nil {
+		return err
+	}
+	fillCurrency(v, requestCurrency(opts))
+	return nil
+}
+
+// requestCurrency returns the currency requested by the options, or else
+// DefaultCurrency.
+func requestCurrency(opts []RequestOption) string {
+	if currency := newRequestOptions(opts).header.Get(LocaleCurrencyHeader); currency != "" {
+		return currency
+	}
+	return DefaultCurrency
+}
+
+// do sends the request with the DigiKey authorization headers attached and
+// returns the response body. Successful responses are stored in and served
+// from the client's cache, if it has one, subject to the request options.
+func (c *Client) do(ctx context.Context, req *http.Request, opts ...RequestOption) ([]byte, error) {
+	o := newRequestOptions(opts)
+	for k, v := range o.header {
+		req.Header[k] = v
 	}
-	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if o.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, o.timeout)
+		defer cancel()
+	}
+	if c.offline {
+		return c.offlineLookup(ctx, req, o)
+	}
+	key, cached, stale, err := c.cacheLookup(ctx, req, o)
+	if stale {
+		c.revalidate(ctx, key, req, o)
+	}
+	if cached != nil && o.metadata != nil {
+		*o.metadata = ResponseMetadata{Cached: true, CacheKey: key}
+	}
+	if err != nil || cached != nil {
+		return cached, err
+	}
+
+	body, err := c.send(ctx, req, o)
 	if err != nil {
+		return nil, err
+	}
+	c.cacheStore(ctx, key, body)
+	if o.metadata != nil {
+		o.metadata.CacheKey = key
+	}
+	return body, nil
+}
+
+// send sends the request with the DigiKey authorization headers attached and
+// returns the response body.
+func (c *Client) send(ctx context.Context, req *http.Request, o *requestOptions) ([]byte, error) {
+	// Ask for a compressed response explicitly, rather than relying on the
+	// transport, so custom transports benefit too.
+	if req.Header.Get("Accept-Encoding") == "" {
+		req.Header.Set("Accept-Encoding", "gzip")
+	}
+	start := time.Now()
+	var resp *http.Response
+	var body []byte
+	var err error
+	if c.hedging != nil && req.Method == http.MethodGet && req.Body == nil {
+		resp, body, err = c.hedgedFetch(ctx, req, o)
+	} else {
+		resp, body, err = c.fetch(ctx, req, o)
+	}
+	if resp == nil {
 		return []byte{}, err
 	}
-	defer resp.Body.Close()
-	// Even if GET didn't return an error, check the status code to make sure
-	// everything was ok.
-	if resp.StatusCode != http.StatusOK {
-		b, err := io.ReadAll(resp.Body)
+	if o.metadata != nil {
+		*o.metadata = newResponseMetadata(resp, time.Since(start))
+	}
+	// Even if the request didn't return an error, check the status code to
+	// make sure everything was ok.
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
 		msg := ""
 
 		if err == nil {
-			msg = string(b)
+			msg = string(body)
+		}
+
+		retryAfter, _ := parseRetryAfter(resp.Header, time.Now())
+		return []byte{}, Error{StatusCode: resp.StatusCode, Message: msg, RetryAfter: retryAfter}
+	}
+	return body, err
+}
+
+// stream sends the request with the DigiKey authorization headers attached
+// and returns the response body, decompressed, for the caller to decode as
+// it is read and then close. Streamed responses bypass the cache.
+func (c *Client) stream(ctx context.Context, req *http.Request, opts ...RequestOption) (io.ReadCloser, error) {
+	if c.offline {
+		return nil, ErrOffline
+	}
+	o := newRequestOptions(opts)
+	if o.cacheMode == cacheOnly {
+		return nil, ErrCacheMiss
+	}
+	for k, v := range o.header {
+		req.Header[k] = v
+	}
+	if req.Header.Get("Accept-Encoding") == "" {
+		req.Header.Set("Accept-Encoding", "gzip")
+	}
+	cancel := context.CancelFunc(func() {})
+	if o.timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, o.timeout)
+	}
+	start := time.Now()
+	resp, err := c.roundTrip(ctx, req, o)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	if o.metadata != nil {
+		*o.metadata = newResponseMetadata(resp, time.Since(start))
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		defer cancel()
+		defer resp.Body.Close()
+		msg, _ := readBody(resp)
+		retryAfter, _ := parseRetryAfter(resp.Header, time.Now())
+		return nil, Error{StatusCode: resp.StatusCode, Message: string(msg), RetryAfter: retryAfter}
+	}
+	s := &streamBody{body: resp.Body, cancel: cancel}
+	s.r = resp.Body
+	if strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		zr, err := gzip.NewReader(resp.Body)
+		if err != nil {
+			s.Close()
+			return nil, fmt.Errorf("error decompressing response body: %w", err)
+		}
+		s.r = zr
+	}
+	return s, nil
+}
+
+// streamBody is a response body being streamed. Closing it closes the
+// underlying body and releases the request's timeout.
+type streamBody struct {
+	r      io.Reader
+	body   io.Closer
+	cancel context.CancelFunc
+}
+
+func (s *streamBody) Read(p []byte) (int, error) {
+	n, err := s.r.Read(p)
+	if err != nil && err != io.EOF {
+		err = fmt.Errorf("error reading response body: %w", err)
+	}
+	return n, err
+}
+
+func (s *streamBody) Close() error {
+	defer s.cancel()
+	return s.body.Close()
+}
+
+// fetch sends the request and reads the response body. The response is
+// returned, with its body closed, for its status and headers.
+func (c *Client) fetch(ctx context.Context, req *http.Request, o *requestOptions) (*http.Response, []byte, error) {
+	resp, err := c.roundTrip(ctx, req, o)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+	body, err := readBody(resp)
+	return resp, body, err
+}
+
+// readBody reads the response body, decompressing it if it is gzipped.
+func readBody(resp *http.Response) ([]byte, error) {
+	if !strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		return readAll(resp.Body)
+	}
+	zr, err := getGzipReader(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing response body: %w", err)
+	}
+	defer gzipReaderPool.Put(zr)
+	body, err := readAll(zr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing response body: %w", err)
+	}
+	return body, nil
+}
+
+// maxPooledBuffer is the capacity above which a buffer is dropped rather
+// than returned to the pool, so one huge response doesn't pin its memory.
+const maxPooledBuffer = 4 << 20
+
+// bufferPool holds the buffers response bodies are read into, so that
+// reading a body under high request rates allocates only its copy rather
+// than every step of a growing slice.
+var bufferPool = sync.Pool{
+	New: func() any { return new(bytes.Buffer) },
+}
+
+// gzipReaderPool holds gzip readers to be reset onto new response bodies.
+var gzipReaderPool sync.Pool
+
+// readAll reads r to the end through a pooled buffer and returns a copy of
+// the data, which the caller owns.
+func readAll(r io.Reader) ([]byte, error) {
+	buf := bufferPool.Get().(*bytes.Buffer)
+	buf.Reset()
+	defer func() {
+		if buf.Cap() <= maxPooledBuffer {
+			bufferPool.Put(buf)
+		}
+	}()
+	_, err := buf.ReadFrom(r)
+	data := make([]byte, buf.Len())
+	copy(data, buf.Bytes())
+	return data, err
+}
+
+// getGzipReader returns a pooled gzip reader reset to read from r.
+func getGzipReader(r io.Reader) (*gzip.Reader, error) {
+	if zr, ok := gzipReaderPool.Get().(*gzip.Reader); ok {
+		if err := zr.Reset(r); err != nil {
+			gzipReaderPool.Put(zr)
+			return nil, err
+		}
+		return zr, nil
+	}
+	return gzip.NewReader(r)
+}
+
+// roundTrip sends the request, subject to the rate limiter and quota, and
+// returns the response. The authorization headers are attached by the
+// transport of the API client.
+func (c *Client) roundTrip(ctx context.Context, req *http.Request, o *requestOptions) (*http.Response, error) {
+	if c.offline {
+		return nil, ErrOffline
+	}
+	if req.Header.Get("Accept") == "" {
+		req.Header.Set("Accept", "application/json")
+	}
+	if req.Header.Get("User-Agent") == "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+
+	var probe bool
+	if c.breaker != nil {
+		var err error
+		if probe, err = c.breaker.allow(); err != nil {
+			return nil, err
 		}
+	}
+	err := c.rateLimiter.Wait(ctx)
+	if err == nil && c.quota != nil {
+		err = c.quota.acquire(o.reservation)
+	}
+	if err != nil {
+		if c.breaker != nil {
+			// The request was never sent, so it says nothing of the API.
+			c.breaker.record(probe, nil, context.Canceled)
+		}
+		return nil, err
+	}
+	resp, err := c.apiClient.Do(req.WithContext(ctx))
+	if c.breaker != nil {
+		c.breaker.record(probe, resp, err)
+	}
+	if err != nil {
+		// Report token failures as such rather than as a failed request.
+		var tokenErr *TokenError
+		if errors.As(err, &tokenErr) {
+			return nil, tokenErr
+		}
+		return nil, err
+	}
+	if c.quota != nil {
+		c.quota.update(resp.Header)
+	}
+	return resp, nil
+}
 
-		return []byte{}, Error{StatusCode: resp.StatusCode, Message: msg}
+// Do sends a request the package does not wrap, such as an endpoint added
+// to the API after this release, with the DigiKey authorization headers
+// attached, subject to the rate limiter and quota. A request URL without a
+// host, e.g. "products/v4/search/..." is resolved against the client's base
+// URL. Request options that set headers or draw on a reservation apply;
+// caching and timeouts do not, so use the request's context to bound it.
+//
+// Throttled (429) and server error (5xx) responses are retried as set by
+// WithRetries, by default up to DefaultMaxRetries times, but only if the
+// request can safely be sent again: a GET, HEAD, OPTIONS, PUT, or DELETE,
+// or another method with the AllowRetry option, without a body or with a
+// body its GetBody recreates, as http.NewRequest sets for in-memory bodies.
+// Retries stop early if the context's deadline would pass before the next
+// attempt.
+//
+// Unlike the wrapped endpoints, Do returns the response whatever its status
+// code, including the last one retried, and the caller must close its body.
+func (c *Client) Do(ctx context.Context, req *http.Request, opts ...RequestOption) (*http.Response, error) {
+	o := newRequestOptions(opts)
+	// Leave the caller's request unchanged.
+	req = req.Clone(ctx)
+	if req.URL.Host == "" {
+		u, err := c.url(strings.TrimPrefix(req.URL.String(), "/"), nil)
+		if err != nil {
+			return nil, err
+		}
+		req.URL = u
+		req.Host = ""
+	}
+	if req.Header == nil {
+		req.Header = make(http.Header)
+	}
+	for k, v := range o.header {
+		req.Header[k] = v
 	}
-	return io.ReadAll(resp.Body)
+	return c.doWithRetries(ctx, req, o)
 }
 
 // Returns a URL object that points to the endpoint with optional query parameters.
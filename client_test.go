@@ -0,0 +1,234 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newTestClient returns a client of a server answering with the handler,
+// authorized by a static token and without rate limiting.
+func newTestClient(t *testing.T, h http.HandlerFunc, opts ...ClientOption) *Client {
+	t.Helper()
+	srv := httptest.NewServer(h)
+	t.Cleanup(srv.Close)
+	opts = append([]ClientOption{WithBaseURL(srv.URL + "/"), WithAccessToken("token"), WithNoRateLimit()}, opts...)
+	c, err := NewClient("id", "secret", opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+// failing returns a handler that responds with the status until it has
+// been called n times, and 200 OK after, counting the calls.
+func failing(status, n int, calls *atomic.Int32, header http.Header) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if int(calls.Add(1)) <= n {
+			for k, v := range header {
+				w.Header()[k] = v
+			}
+			http.Error(w, "unavailable", status)
+			return
+		}
+		w.Write(body)
+	}
+}
+
+func TestWithBaseURL(t *testing.T) {
+	for _, tt := range []struct{ baseURL, want string }{
+		{"https://api.digikey.com/", "https://api.digikey.com/"},
+		{"https://api.digikey.com", "https://api.digikey.com/"},
+		{"https://api.digikey.com/v1/", "https://api.digikey.com/"},
+		{"https://api.digikey.com/v1", "https://api.digikey.com/"},
+		{"http://localhost:8080/proxy/", "http://localhost:8080/proxy/"},
+	} {
+		c, err := NewClient("id", "secret", WithBaseURL(tt.baseURL), WithAccessToken("token"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if c.baseURL != tt.want {
+			t.Errorf("WithBaseURL(%q) sets %q, want %q", tt.baseURL, c.baseURL, tt.want)
+		}
+	}
+}
+
+func TestDoRetries(t *testing.T) {
+	var calls atomic.Int32
+	c := newTestClient(t, failing(http.StatusServiceUnavailable, 2, &calls, nil), WithRetries(3, time.Millisecond, 10*time.Millisecond))
+	req, _ := http.NewRequest(http.MethodPost, "products/v4/search/keyword", strings.NewReader(`{"Keywords":"lm358"}`))
+	resp, err := c.Do(context.Background(), req, AllowRetry())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK || calls.Load() != 3 {
+		t.Errorf("Do() = %d after %d calls, want 200 after 3", resp.StatusCode, calls.Load())
+	}
+	if string(body) != `{"Keywords":"lm358"}` {
+		t.Errorf("body of the last attempt = %q, want the request body replayed", body)
+	}
+}
+
+func TestDoRetryLimits(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		header http.Header
+		method string
+		body   io.Reader
+		opts   []RequestOption
+		want   int32
+	}{
+		{"exhausted", http.StatusBadGateway, nil, http.MethodGet, nil, nil, 3},
+		{"client error", http.StatusBadRequest, nil, http.MethodGet, nil, nil, 1},
+		{"POST", http.StatusServiceUnavailable, nil, http.MethodPost, strings.NewReader("{}"), nil, 1},
+		{"POST without body", http.StatusServiceUnavailable, nil, http.MethodPost, nil, nil, 1},
+		{"POST allowed", http.StatusServiceUnavailable, nil, http.MethodPost, nil, []RequestOption{AllowRetry()}, 3},
+		{"body without GetBody", http.StatusServiceUnavailable, nil, http.MethodPost, io.NopCloser(strings.NewReader("{}")), []RequestOption{AllowRetry()}, 1},
+		{"PUT with body", http.StatusServiceUnavailable, nil, http.MethodPut, strings.NewReader("{}"), nil, 3},
+		{"long Retry-After", http.StatusTooManyRequests, http.Header{"Retry-After": {"3600"}}, http.MethodGet, nil, nil, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int32
+			c := newTestClient(t, failing(tt.status, 10, &calls, tt.header), WithRetries(2, time.Millisecond, time.Second))
+			req, _ := http.NewRequest(tt.method, "products/v4/search/manufacturers", tt.body)
+			resp, err := c.Do(context.Background(), req, tt.opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != tt.status || calls.Load() != tt.want {
+				t.Errorf("Do() = %d after %d calls, want %d after %d", resp.StatusCode, calls.Load(), tt.status, tt.want)
+			}
+		})
+	}
+}
+
+func TestDoHonorsRetryAfter(t *testing.T) {
+	var calls atomic.Int32
+	c := newTestClient(t, failing(http.StatusTooManyRequests, 1, &calls, http.Header{"Retry-After": {"1"}}), WithRetries(1, time.Millisecond, time.Minute))
+	req, _ := http.NewRequest(http.MethodGet, "products/v4/search/manufacturers", nil)
+	start := time.Now()
+	resp, err := c.Do(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if elapsed := time.Since(start); resp.StatusCode != http.StatusOK || elapsed < time.Second {
+		t.Errorf("Do() = %d after %s, want 200 after the second Retry-After asks for", resp.StatusCode, elapsed)
+	}
+
+	// A deadline before the retry is due returns the throttled response.
+	calls.Store(0)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	resp, err = c.Do(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusTooManyRequests || calls.Load() != 1 {
+		t.Errorf("Do() with a near deadline = %d after %d calls, want 429 after 1", resp.StatusCode, calls.Load())
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		value string
+		want  time.Duration
+		ok    bool
+	}{
+		{"", 0, false},
+		{"120", 2 * time.Minute, true},
+		{"Sun, 01 Jun 2025 12:00:30 GMT", 30 * time.Second, true},
+		{"Sun, 01 Jun 2025 11:00:00 GMT", 0, true},
+		{"soon", 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := parseRetryAfter(http.Header{"Retry-After": {tt.value}}, now)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("parseRetryAfter(%q) = %s, %v, want %s, %v", tt.value, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	tests := []struct {
+		status int
+		header http.Header
+		want   time.Duration
+		ok     bool
+	}{
+		{http.StatusTooManyRequests, http.Header{"Retry-After": {"7"}}, 7 * time.Second, true},
+		{http.StatusTooManyRequests, nil, DefaultRetryAfter, true},
+		{http.StatusServiceUnavailable, http.Header{"Retry-After": {"7"}}, 0, false},
+	}
+	for _, tt := range tests {
+		var calls atomic.Int32
+		c := newTestClient(t, failing(tt.status, 10, &calls, tt.header), WithRetries(0, 0, 0))
+		_, err := c.Products.ProductDetails(context.Background(), "296-1395-5-ND")
+		if got, ok := RetryAfter(err); got != tt.want || ok != tt.ok {
+			t.Errorf("RetryAfter(%v) = %s, %v, want %s, %v", err, got, ok, tt.want, tt.ok)
+		}
+	}
+	if _, ok := RetryAfter(nil); ok {
+		t.Error("RetryAfter(nil) reports a throttled request")
+	}
+}
+
+func TestReadBody(t *testing.T) {
+	want := bytes.Repeat([]byte(`{"Products":[]}`), 1000)
+	got, err := readBody(&http.Response{Body: io.NopCloser(bytes.NewReader(want))})
+	if err != nil || !bytes.Equal(got, want) {
+		t.Fatalf("readBody() = %d bytes, %v, want %d bytes", len(got), err, len(want))
+	}
+	// The returned body must not share the pooled buffer.
+	again, _ := readBody(&http.Response{Body: io.NopCloser(strings.NewReader("overwritten"))})
+	if !bytes.Equal(got, want) || string(again) != "overwritten" {
+		t.Error("readBody() result changed when the next body was read")
+	}
+}
+
+// BenchmarkReadBody compares reading response bodies through the pooled
+// buffers with io.ReadAll, at the sizes of a token, a product, and a full
+// page of search results.
+func BenchmarkReadBody(b *testing.B) {
+	for _, size := range []int{512, 16 << 10, 512 << 10} {
+		data := bytes.Repeat([]byte("x"), size)
+		b.Run(fmt.Sprintf("pooled/%d", size), func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(size))
+			for b.Loop() {
+				if _, err := readBody(&http.Response{Body: io.NopCloser(bytes.NewReader(data))}); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("ReadAll/%d", size), func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(size))
+			for b.Loop() {
+				if _, err := io.ReadAll(bytes.NewReader(data)); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
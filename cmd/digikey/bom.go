@@ -0,0 +1,231 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/apidepot/digikey"
+	"github.com/apidepot/digikey/bom"
+)
+
+// quoteLine is a costed BOM line as written by -output json and yaml.
+type quoteLine struct {
+	RefDes            string   `json:"refDes,omitempty"`
+	MPN               string   `json:"mpn"`
+	Manufacturer      string   `json:"manufacturer,omitempty"`
+	DigiKeyPartNumber string   `json:"digiKeyPartNumber,omitempty"`
+	Required          int      `json:"required"`
+	OrderQuantity     int      `json:"orderQuantity,omitempty"`
+	UnitPrice         float64  `json:"unitPrice,omitempty"`
+	ExtendedPrice     float64  `json:"extendedPrice,omitempty"`
+	QuantityAvailable int      `json:"quantityAvailable"`
+	LeadTimeWeeks     int      `json:"leadTimeWeeks,omitempty"`
+	Confidence        string   `json:"confidence"`
+	Unavailable       []string `json:"unavailable,omitempty"`
+	Error             string   `json:"error,omitempty"`
+}
+
+// quote is a costed BOM as written by -output json and yaml.
+type quote struct {
+	BuildQuantity int         `json:"buildQuantity"`
+	Lines         []quoteLine `json:"lines"`
+	Total         float64     `json:"total"`
+	PerUnit       float64     `json:"perUnit"`
+	Currency      string      `json:"currency,omitempty"`
+	LeadTimeWeeks int         `json:"leadTimeWeeks"`
+}
+
+func setupBOMQuote(fs *flag.FlagSet) func(context.Context, *flag.FlagSet) error {
+	var cf clientFlags
+	cf.register(fs)
+	qty := fs.Int("qty", 1, "number of boards to build")
+	output := fs.String("output", formatTable, "output format: table, json, csv, or yaml")
+	xlsxPath := fs.String("xlsx", "", "also export the costed BOM to this XLSX file")
+	failFast := fs.Bool("fail-fast", false, "fail if any data is unavailable rather than quote without it")
+	return func(ctx context.Context, fs *flag.FlagSet) error {
+		if fs.NArg() != 1 {
+			return usagef("expected a single BOM file")
+		}
+		if *qty <= 0 {
+			return usagef("-qty must be positive")
+		}
+		if err := checkFormat(*output, formatTable, formatJSON, formatCSV, formatYAML); err != nil {
+			return err
+		}
+
+		f, err := os.Open(fs.Arg(0))
+		if err != nil {
+			return err
+		}
+		lines, err := bom.ReadCSV(f, bom.DefaultColumnMapping)
+		f.Close()
+		if err != nil {
+			return err
+		}
+		// Enrich at the build quantity, so packaging is chosen for the volume
+		// actually being bought.
+		for i := range lines {
+			lines[i].Quantity *= *qty
+		}
+
+		c, err := cf.client()
+		if err != nil {
+			return err
+		}
+		policy := bom.DegradeAll
+		if *failFast {
+			policy = bom.FailFast
+		}
+		enriched, err := bom.NewEnricher(c, bom.WithDegradationPolicy(policy)).Enrich(ctx, lines)
+		if err != nil {
+			return err
+		}
+		rollups, err := bom.CostRollup(ctx, enriched, []int{1})
+		if err != nil {
+			return err
+		}
+		rollup := rollups[0]
+		// Replace the enrichment's pricing with the optimized order.
+		for i, lc := range rollup.Lines {
+			if lc.Err == nil {
+				enriched[i].DigiKeyPartNumber = lc.DigiKeyPartNumber
+				enriched[i].UnitPrice = lc.UnitPrice
+				enriched[i].ExtendedPrice = lc.ExtendedPrice
+			}
+		}
+		leadTime := bom.CriticalLeadTime(enriched)
+
+		if *xlsxPath != "" {
+			if err := writeXLSXFile(*xlsxPath, enriched); err != nil {
+				return err
+			}
+		}
+
+		q := quote{
+			BuildQuantity: *qty,
+			Total:         rollup.Total.Amount,
+			PerUnit:       rollup.Total.Amount / float64(*qty),
+			Currency:      rollup.Total.Currency,
+			LeadTimeWeeks: leadTime.Weeks,
+		}
+		for i, lc := range rollup.Lines {
+			e := enriched[i]
+			ql := quoteLine{
+				RefDes:            e.RefDes,
+				MPN:               e.MPN,
+				Manufacturer:      e.Manufacturer,
+				DigiKeyPartNumber: lc.DigiKeyPartNumber,
+				Required:          lc.Required,
+				OrderQuantity:     lc.OrderQuantity,
+				UnitPrice:         lc.UnitPrice.Amount,
+				ExtendedPrice:     lc.ExtendedPrice.Amount,
+				QuantityAvailable: e.QuantityAvailable,
+				LeadTimeWeeks:     e.LeadTimeWeeks,
+				Confidence:        e.Confidence.String(),
+				Unavailable:       e.Unavailable.Names(),
+			}
+			if lc.Err != nil {
+				ql.Error = lc.Err.Error()
+			}
+			q.Lines = append(q.Lines, ql)
+		}
+
+		switch *output {
+		case formatJSON:
+			return writeJSON(os.Stdout, q)
+		case formatYAML:
+			return writeYAML(os.Stdout, q)
+		case formatCSV:
+			return writeQuoteCSV(os.Stdout, q)
+		}
+		rows := make([][]string, 0, len(q.Lines))
+		for _, l := range q.Lines {
+			rows = append(rows, []string{
+				truncate(l.RefDes, 20),
+				l.MPN,
+				l.DigiKeyPartNumber,
+				strconv.Itoa(l.Required),
+				formatQuantity(l.OrderQuantity),
+				formatPrice(digikey.NewMoney(l.UnitPrice, q.Currency)),
+				formatPrice(digikey.NewMoney(l.ExtendedPrice, q.Currency)),
+				strconv.Itoa(l.QuantityAvailable),
+				l.Confidence,
+				l.Error,
+			})
+		}
+		header := []string{"REFDES", "MPN", "DIGIKEY PN", "REQUIRED", "ORDER", "UNIT PRICE", "EXTENDED", "AVAILABLE", "MATCH", "ERROR"}
+		if err := writeTable(os.Stdout, header, rows); err != nil {
+			return err
+		}
+		fmt.Printf("\nTotal for %d: %.2f %s (%.4f per board)\n", q.BuildQuantity, q.Total, q.Currency, q.PerUnit)
+		if leadTime.Weeks > 0 {
+			fmt.Printf("Lead time: %d weeks, gated by", leadTime.Weeks)
+			for _, l := range leadTime.Gating {
+				fmt.Printf(" %s", l.MPN)
+			}
+			fmt.Println()
+		}
+		if n := len(leadTime.Unknown); n > 0 {
+			fmt.Printf("Lead time unknown for %d lines\n", n)
+		}
+		return nil
+	}
+}
+
+// writeQuoteCSV writes a record per line of the quote, with the fields of
+// -output json.
+func writeQuoteCSV(w io.Writer, q quote) error {
+	header := []string{"refDes", "mpn", "manufacturer", "digiKeyPartNumber", "required", "orderQuantity",
+		"unitPrice", "extendedPrice", "currency", "quantityAvailable", "leadTimeWeeks", "confidence", "unavailable", "error"}
+	rows := make([][]string, 0, len(q.Lines))
+	for _, l := range q.Lines {
+		rows = append(rows, []string{
+			l.RefDes,
+			l.MPN,
+			l.Manufacturer,
+			l.DigiKeyPartNumber,
+			strconv.Itoa(l.Required),
+			strconv.Itoa(l.OrderQuantity),
+			strconv.FormatFloat(l.UnitPrice, 'f', -1, 64),
+			strconv.FormatFloat(l.ExtendedPrice, 'f', -1, 64),
+			q.Currency,
+			strconv.Itoa(l.QuantityAvailable),
+			strconv.Itoa(l.LeadTimeWeeks),
+			l.Confidence,
+			strings.Join(l.Unavailable, ";"),
+			l.Error,
+		})
+	}
+	return writeCSV(w, header, rows)
+}
+
+// writeXLSXFile exports the enriched BOM to the named XLSX file.
+func writeXLSXFile(path string, lines []bom.EnrichedLine) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := bom.WriteXLSX(f, lines); err != nil {
+		f.Close()
+		return fmt.Errorf("error writing %s: %w", path, err)
+	}
+	return f.Close()
+}
+
+// formatQuantity formats a quantity, leaving zero blank.
+func formatQuantity(n int) string {
+	if n == 0 {
+		return ""
+	}
+	return strconv.Itoa(n)
+}
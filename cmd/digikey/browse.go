@@ -0,0 +1,582 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/apidepot/digikey"
+	"github.com/apidepot/digikey/bom"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// The browse command is an interactive terminal UI for finding parts and
+// collecting them into a working BOM. It has four panes:
+//
+//	search   type keywords, enter to search
+//	results  ↑/↓ to move, a or enter to add the part to the BOM,
+//	         f to filter, b for the BOM, / to search again
+//	filters  type to narrow the filters, enter to toggle one, esc to return
+//	bom      ↑/↓ to move, +/- to change the quantity, x to remove the line
+//
+// q quits from the results and BOM panes, ctrl+c from any pane. The BOM is
+// read from the file given, if it exists, and written back on quitting.
+
+func setupBrowse(fs *flag.FlagSet) func(context.Context, *flag.FlagSet) error {
+	var cf clientFlags
+	cf.register(fs)
+	inStock := fs.Bool("in-stock", false, "only products in stock")
+	limit := fs.Int("limit", 50, "maximum number of products per search")
+	return func(ctx context.Context, fs *flag.FlagSet) error {
+		if fs.NArg() > 1 {
+			return usagef("expected at most one BOM file")
+		}
+		path := fs.Arg(0)
+		lines, err := readWorkingBOM(path)
+		if err != nil {
+			return err
+		}
+		c, err := cf.client()
+		if err != nil {
+			return err
+		}
+
+		m := newBrowseModel(ctx, func(ctx context.Context, req digikey.KeywordRequest) (*digikey.KeywordResponse, error) {
+			return c.Products.KeywordSearch(ctx, req)
+		}, lines)
+		m.inStock, m.limit = *inStock, *limit
+		final, err := tea.NewProgram(m, tea.WithAltScreen(), tea.WithContext(ctx)).Run()
+		if errors.Is(err, tea.ErrProgramKilled) {
+			err = ctx.Err()
+		}
+		if err != nil {
+			return err
+		}
+		m = final.(*browseModel)
+		if path == "" || !m.changed {
+			return nil
+		}
+		return writeWorkingBOM(path, m.bom)
+	}
+}
+
+// readWorkingBOM reads the BOM file at path, if there is one.
+func readWorkingBOM(path string) ([]bom.BOMLine, error) {
+	if path == "" {
+		return nil, nil
+	}
+	f, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return bom.ReadCSV(f, bom.DefaultColumnMapping)
+}
+
+// writeWorkingBOM writes the BOM lines to the CSV file at path, with the
+// columns bom quote reads.
+func writeWorkingBOM(path string, lines []bom.BOMLine) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	rows := make([][]string, 0, len(lines))
+	for _, l := range lines {
+		rows = append(rows, []string{l.MPN, l.Manufacturer, strconv.Itoa(l.Quantity), l.RefDes})
+	}
+	if err := writeCSV(f, []string{"MPN", "Manufacturer", "Quantity", "RefDes"}, rows); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// pane is the part of the browser that has the focus.
+type pane int
+
+const (
+	paneSearch pane = iota
+	paneResults
+	paneFilters
+	paneBOM
+)
+
+// searchFunc runs a keyword search.
+type searchFunc func(context.Context, digikey.KeywordRequest) (*digikey.KeywordResponse, error)
+
+// filterChoice is a filter offered by a search response: a manufacturer, or
+// a value of a parameter of a category.
+type filterChoice struct {
+	manufacturerID int
+	categoryID     int
+	parameterID    int
+	valueID        string
+	label          string
+	count          int
+}
+
+// key identifies the filter across searches.
+func (f filterChoice) key() string {
+	if f.manufacturerID != 0 {
+		return "m" + strconv.Itoa(f.manufacturerID)
+	}
+	return fmt.Sprintf("p%d/%d/%s", f.categoryID, f.parameterID, f.valueID)
+}
+
+// searchDoneMsg carries the result of the search with the given sequence
+// number.
+type searchDoneMsg struct {
+	seq  int
+	resp *digikey.KeywordResponse
+	err  error
+}
+
+// browseModel is the state of the browser.
+type browseModel struct {
+	ctx     context.Context
+	search  searchFunc
+	inStock bool
+	limit   int
+
+	pane     pane
+	keywords textinput.Model
+	query    textinput.Model
+	height   int
+
+	// seq numbers searches, so that the results of superseded ones are
+	// dropped.
+	seq       int
+	searching bool
+	resp      *digikey.KeywordResponse
+	err       error
+	cursor    int
+
+	selected  []filterChoice
+	choices   []filterChoice
+	filterPos int
+
+	bom     []bom.BOMLine
+	bomPos  int
+	changed bool
+}
+
+func newBrowseModel(ctx context.Context, search searchFunc, lines []bom.BOMLine) *browseModel {
+	m := &browseModel{ctx: ctx, search: search, limit: 50, bom: lines}
+	m.keywords = textinput.New()
+	m.keywords.Prompt = "search: "
+	m.keywords.Placeholder = "keywords or part number"
+	m.keywords.Focus()
+	m.query = textinput.New()
+	m.query.Prompt = "filter: "
+	return m
+}
+
+func (m *browseModel) Init() tea.Cmd {
+	return textinput.Blink
+}
+
+func (m *browseModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.height = msg.Height
+		return m, nil
+	case searchDoneMsg:
+		if msg.seq != m.seq {
+			return m, nil
+		}
+		m.searching = false
+		m.resp, m.err = msg.resp, msg.err
+		m.cursor = 0
+		m.updateChoices()
+		return m, nil
+	case tea.KeyMsg:
+		if msg.String() == "ctrl+c" {
+			return m, tea.Quit
+		}
+		switch m.pane {
+		case paneSearch:
+			return m.updateSearch(msg)
+		case paneResults:
+			return m.updateResults(msg)
+		case paneFilters:
+			return m.updateFilters(msg)
+		case paneBOM:
+			return m.updateBOM(msg)
+		}
+	}
+	return m, nil
+}
+
+func (m *browseModel) updateSearch(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "enter":
+		if strings.TrimSpace(m.keywords.Value()) == "" {
+			return m, nil
+		}
+		m.selected = nil
+		m.focus(paneResults)
+		return m, m.runSearch()
+	case "esc":
+		if m.resp != nil {
+			m.focus(paneResults)
+		}
+		return m, nil
+	}
+	var cmd tea.Cmd
+	m.keywords, cmd = m.keywords.Update(msg)
+	return m, cmd
+}
+
+func (m *browseModel) updateResults(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	var n int
+	if m.resp != nil {
+		n = len(m.resp.Products)
+	}
+	switch msg.String() {
+	case "q":
+		return m, tea.Quit
+	case "up", "k":
+		m.cursor = max(m.cursor-1, 0)
+	case "down", "j":
+		m.cursor = max(min(m.cursor+1, n-1), 0)
+	case "a", "enter":
+		if m.cursor < n {
+			m.add(m.resp.Products[m.cursor])
+		}
+	case "f":
+		m.focus(paneFilters)
+	case "b":
+		m.focus(paneBOM)
+	case "/":
+		m.focus(paneSearch)
+	}
+	return m, nil
+}
+
+func (m *browseModel) updateFilters(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	visible := m.visibleChoices()
+	switch msg.String() {
+	case "esc":
+		m.focus(paneResults)
+		return m, nil
+	case "up":
+		m.filterPos = max(m.filterPos-1, 0)
+		return m, nil
+	case "down":
+		m.filterPos = max(min(m.filterPos+1, len(visible)-1), 0)
+		return m, nil
+	case "enter":
+		if m.filterPos < len(visible) {
+			m.toggle(visible[m.filterPos])
+			return m, m.runSearch()
+		}
+		return m, nil
+	}
+	var cmd tea.Cmd
+	m.query, cmd = m.query.Update(msg)
+	m.filterPos = 0
+	return m, cmd
+}
+
+func (m *browseModel) updateBOM(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "q":
+		return m, tea.Quit
+	case "esc", "b":
+		m.focus(paneResults)
+	case "/":
+		m.focus(paneSearch)
+	case "up", "k":
+		m.bomPos = max(m.bomPos-1, 0)
+	case "down", "j":
+		m.bomPos = max(min(m.bomPos+1, len(m.bom)-1), 0)
+	case "+", "=":
+		if m.bomPos < len(m.bom) {
+			m.bom[m.bomPos].Quantity++
+			m.changed = true
+		}
+	case "-":
+		if m.bomPos < len(m.bom) && m.bom[m.bomPos].Quantity > 1 {
+			m.bom[m.bomPos].Quantity--
+			m.changed = true
+		}
+	case "x", "delete":
+		if m.bomPos < len(m.bom) {
+			m.bom = append(m.bom[:m.bomPos], m.bom[m.bomPos+1:]...)
+			m.bomPos = max(min(m.bomPos, len(m.bom)-1), 0)
+			m.changed = true
+		}
+	}
+	return m, nil
+}
+
+// focus moves the focus to the pane, focusing its text input if it has one.
+func (m *browseModel) focus(p pane) {
+	m.pane = p
+	m.keywords.Blur()
+	m.query.Blur()
+	switch p {
+	case paneSearch:
+		m.keywords.Focus()
+	case paneFilters:
+		m.query.SetValue("")
+		m.query.Focus()
+		m.filterPos = 0
+	}
+}
+
+// runSearch returns the command running the search for the keywords and
+// selected filters.
+func (m *browseModel) runSearch() tea.Cmd {
+	m.seq++
+	m.searching = true
+	seq, req, search, ctx := m.seq, m.request(), m.search, m.ctx
+	return func() tea.Msg {
+		resp, err := search(ctx, req)
+		return searchDoneMsg{seq: seq, resp: resp, err: err}
+	}
+}
+
+// request returns the keyword search request for the keywords and selected
+// filters. Values of the same parameter are alternatives.
+func (m *browseModel) request() digikey.KeywordRequest {
+	req := digikey.NewSearchRequest(strings.TrimSpace(m.keywords.Value())).Limit(m.limit)
+	if m.inStock {
+		req.InStockOnly()
+	}
+	values := make(map[int][]string)
+	var params []filterChoice
+	for _, f := range m.selected {
+		if f.manufacturerID != 0 {
+			req.ManufacturerID(f.manufacturerID)
+			continue
+		}
+		if _, ok := values[f.parameterID]; !ok {
+			params = append(params, f)
+		}
+		values[f.parameterID] = append(values[f.parameterID], f.valueID)
+	}
+	for _, f := range params {
+		req.Parameter(f.categoryID, f.parameterID, values[f.parameterID]...)
+	}
+	return req.Build()
+}
+
+// toggle selects the filter, or deselects it if it is selected. Parametric
+// filters apply to a single category, so selecting a parameter of another
+// category deselects those of the previous one.
+func (m *browseModel) toggle(f filterChoice) {
+	for i, s := range m.selected {
+		if s.key() == f.key() {
+			m.selected = append(m.selected[:i], m.selected[i+1:]...)
+			return
+		}
+	}
+	if f.manufacturerID == 0 {
+		kept := m.selected[:0]
+		for _, s := range m.selected {
+			if s.manufacturerID != 0 || s.categoryID == f.categoryID {
+				kept = append(kept, s)
+			}
+		}
+		m.selected = kept
+	}
+	m.selected = append(m.selected, f)
+}
+
+// updateChoices lists the filters offered by the search response, after
+// the selected filters.
+func (m *browseModel) updateChoices() {
+	m.choices = append([]filterChoice(nil), m.selected...)
+	if m.resp == nil {
+		return
+	}
+	seen := make(map[string]bool)
+	for _, f := range m.selected {
+		seen[f.key()] = true
+	}
+	add := func(f filterChoice) {
+		if !seen[f.key()] {
+			seen[f.key()] = true
+			m.choices = append(m.choices, f)
+		}
+	}
+	opts := m.resp.FilterOptions
+	for _, mf := range opts.Manufacturers {
+		add(filterChoice{manufacturerID: mf.ID, label: "Manufacturer: " + mf.Value, count: mf.ProductCount})
+	}
+	for _, p := range opts.ParametricFilters {
+		for _, v := range p.FilterValues {
+			add(filterChoice{
+				categoryID:  p.Category.ID,
+				parameterID: p.ParameterID,
+				valueID:     v.ValueID,
+				label:       p.ParameterName + ": " + v.ValueName,
+				count:       v.ProductCount,
+			})
+		}
+	}
+}
+
+// visibleChoices returns the filters whose labels contain every word of
+// the filter query, ignoring case.
+func (m *browseModel) visibleChoices() []filterChoice {
+	words := strings.Fields(strings.ToLower(m.query.Value()))
+	var visible []filterChoice
+next:
+	for _, f := range m.choices {
+		label := strings.ToLower(f.label)
+		for _, w := range words {
+			if !strings.Contains(label, w) {
+				continue next
+			}
+		}
+		visible = append(visible, f)
+	}
+	return visible
+}
+
+// isSelected reports whether the filter is selected.
+func (m *browseModel) isSelected(f filterChoice) bool {
+	for _, s := range m.selected {
+		if s.key() == f.key() {
+			return true
+		}
+	}
+	return false
+}
+
+// add adds a unit of the product to the BOM, adding a line if it has none.
+func (m *browseModel) add(p digikey.Product) {
+	m.changed = true
+	for i, l := range m.bom {
+		if strings.EqualFold(l.MPN, p.ManufacturerProductNumber) && bom.SameManufacturer(l.Manufacturer, p.Manufacturer.Name) {
+			m.bom[i].Quantity++
+			m.bomPos = i
+			return
+		}
+	}
+	m.bom = append(m.bom, bom.BOMLine{MPN: p.ManufacturerProductNumber, Manufacturer: p.Manufacturer.Name, Quantity: 1})
+	m.bomPos = len(m.bom) - 1
+}
+
+func (m *browseModel) View() string {
+	var b strings.Builder
+	b.WriteString(m.keywords.View())
+	b.WriteString("\n\n")
+	rows := max(m.height-6, 5)
+	if m.height == 0 {
+		rows = 20
+	}
+	switch m.pane {
+	case paneFilters:
+		b.WriteString(m.query.View())
+		b.WriteString("\n")
+		var lines []string
+		for _, f := range m.visibleChoices() {
+			mark := "[ ]"
+			if m.isSelected(f) {
+				mark = "[x]"
+			}
+			lines = append(lines, fmt.Sprintf("%s %s (%d)", mark, f.label, f.count))
+		}
+		writeList(&b, lines, m.filterPos, rows-1)
+	case paneBOM:
+		var table [][]string
+		for _, l := range m.bom {
+			table = append(table, []string{l.MPN, l.Manufacturer, strconv.Itoa(l.Quantity), l.RefDes})
+		}
+		writeTableList(&b, []string{"MPN", "MANUFACTURER", "QTY", "REFDES"}, table, m.bomPos, rows)
+	default:
+		if m.resp != nil {
+			writeTableList(&b, resultsHeader, m.resultRows(), m.cursor, rows)
+		}
+	}
+	b.WriteString("\n")
+	b.WriteString(m.status())
+	return b.String()
+}
+
+var resultsHeader = []string{"MPN", "MANUFACTURER", "AVAILABLE", "UNIT PRICE", "DESCRIPTION"}
+
+// resultRows returns the rows of the results table.
+func (m *browseModel) resultRows() [][]string {
+	table := make([][]string, 0, len(m.resp.Products))
+	for _, p := range m.resp.Products {
+		table = append(table, []string{
+			p.ManufacturerProductNumber,
+			truncate(p.Manufacturer.Name, 20),
+			strconv.Itoa(p.QuantityAvailable),
+			formatPrice(p.UnitPrice),
+			truncate(p.Description.ProductDescription, 40),
+		})
+	}
+	return table
+}
+
+// status returns the status line: the state of the search and the keys of
+// the focused pane.
+func (m *browseModel) status() string {
+	var s string
+	switch {
+	case m.searching:
+		s = "searching…"
+	case m.err != nil:
+		s = "error: " + m.err.Error()
+	case m.resp != nil:
+		s = fmt.Sprintf("%d of %d products, %d filters, %d BOM lines", len(m.resp.Products), m.resp.ProductsCount, len(m.selected), len(m.bom))
+		if len(m.resp.Products) == 0 {
+			if kw := m.resp.DidYouMean(); kw != "" {
+				s += fmt.Sprintf(`; did you mean "%s"?`, kw)
+			}
+		}
+	default:
+		s = fmt.Sprintf("%d BOM lines", len(m.bom))
+	}
+	keys := map[pane]string{
+		paneSearch:  "enter search · esc results · ctrl+c quit",
+		paneResults: "a add · f filter · b BOM · / search · q quit",
+		paneFilters: "enter toggle · esc results",
+		paneBOM:     "+/- quantity · x remove · b results · q quit",
+	}
+	return s + "\n" + keys[m.pane]
+}
+
+// writeTableList writes the rows as aligned columns under the header, as a
+// list of up to n lines including the header.
+func writeTableList(b *strings.Builder, header []string, rows [][]string, cursor, n int) {
+	var t strings.Builder
+	writeTable(&t, header, rows)
+	lines := strings.Split(strings.TrimSuffix(t.String(), "\n"), "\n")
+	b.WriteString("  " + lines[0] + "\n")
+	writeList(b, lines[1:], cursor, n-1)
+}
+
+// writeList writes up to n of the lines, scrolled so that the line at
+// cursor is shown and marked.
+func writeList(b *strings.Builder, lines []string, cursor, n int) {
+	start := max(min(cursor-n/2, len(lines)-n), 0)
+	for i := start; i < len(lines) && i < start+n; i++ {
+		if i == cursor {
+			b.WriteString("> ")
+		} else {
+			b.WriteString("  ")
+		}
+		b.WriteString(lines[i])
+		b.WriteString("\n")
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package main
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/apidepot/digikey"
+	"github.com/apidepot/digikey/bom"
+	"github.com/apidepot/digikey/digikeytest"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// press sends the keys to the model, typing runs of other characters, and
+// runs the command of the last key if it is a search.
+func press(t *testing.T, m *browseModel, keys ...string) {
+	t.Helper()
+	var cmd tea.Cmd
+	for _, k := range keys {
+		msg := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)}
+		switch k {
+		case "enter":
+			msg = tea.KeyMsg{Type: tea.KeyEnter}
+		case "esc":
+			msg = tea.KeyMsg{Type: tea.KeyEsc}
+		}
+		_, cmd = m.Update(msg)
+	}
+	if m.searching && cmd != nil {
+		done, ok := cmd().(searchDoneMsg)
+		if !ok {
+			t.Fatalf("command after %q is not a search", keys)
+		}
+		m.Update(done)
+	}
+}
+
+func TestBrowse(t *testing.T) {
+	srv := digikeytest.NewServer("id", "secret")
+	defer srv.Close()
+	c, err := srv.NewClient("id", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := newBrowseModel(context.Background(), func(ctx context.Context, req digikey.KeywordRequest) (*digikey.KeywordResponse, error) {
+		return c.Products.KeywordSearch(ctx, req)
+	}, []bom.BOMLine{{MPN: "RC0603FR-0710KL", Manufacturer: "YAGEO", Quantity: 4, RefDes: "R1-R4"}})
+
+	press(t, m, "lm358", "enter")
+	if m.err != nil {
+		t.Fatal(m.err)
+	}
+	if m.pane != paneResults || m.resp == nil || len(m.resp.Products) != 1 {
+		t.Fatalf("after search: pane %d, response %+v", m.pane, m.resp)
+	}
+	if view := m.View(); !strings.Contains(view, "> LM358DR") {
+		t.Errorf("results view does not show the selected part:\n%s", view)
+	}
+
+	press(t, m, "a", "a")
+	if len(m.bom) != 2 || m.bom[1].MPN != "LM358DR" || m.bom[1].Quantity != 2 {
+		t.Errorf("BOM after adding twice = %+v", m.bom)
+	}
+
+	press(t, m, "f", "circuits", "enter")
+	if len(m.selected) != 1 || m.selected[0].parameterID != 2094 {
+		t.Fatalf("selected filters = %+v", m.selected)
+	}
+	req := m.request()
+	if pf := req.FilterOptionsRequest.ParameterFilterRequest; pf == nil || pf.CategoryFilter.ID != "687" || pf.ParameterFilters[0].FilterValues[0].ID != "2" {
+		t.Errorf("filtered request = %+v", req.FilterOptionsRequest)
+	}
+	if m.err != nil || len(m.resp.Products) != 1 {
+		t.Errorf("filtered search = %v, %+v", m.err, m.resp)
+	}
+
+	press(t, m, "esc", "b", "-")
+	if m.bom[1].Quantity != 1 {
+		t.Errorf("quantity after - = %d, want 1", m.bom[1].Quantity)
+	}
+	press(t, m, "k", "x")
+	if len(m.bom) != 1 || m.bom[0].MPN != "LM358DR" || !m.changed {
+		t.Errorf("BOM after removing the first line = %+v", m.bom)
+	}
+}
+
+func TestWorkingBOM(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bom.csv")
+	lines, err := readWorkingBOM(path)
+	if err != nil || lines != nil {
+		t.Fatalf("readWorkingBOM() of a missing file = %v, %v", lines, err)
+	}
+	want := []bom.BOMLine{
+		{MPN: "LM358DR", Manufacturer: "Texas Instruments", Quantity: 2, RefDes: "U1,U2"},
+		{MPN: "RC0603FR-0710KL", Manufacturer: "YAGEO", Quantity: 1},
+	}
+	if err := writeWorkingBOM(path, want); err != nil {
+		t.Fatal(err)
+	}
+	got, err := readWorkingBOM(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("readWorkingBOM() = %+v, want %+v", got, want)
+	}
+}
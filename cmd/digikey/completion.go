@@ -0,0 +1,174 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+)
+
+// Completion scripts are generated from the commands and their flags, so
+// they cannot fall out of date.
+// They are loaded from the shell's startup file, or for fish saved to its
+// completions directory:
+//
+//	source <(digikey completion bash)
+//	source <(digikey completion zsh)
+//	digikey completion fish > ~/.config/fish/completions/digikey.fish
+
+func setupCompletion(fs *flag.FlagSet) func(context.Context, *flag.FlagSet) error {
+	return func(ctx context.Context, fs *flag.FlagSet) error {
+		if fs.NArg() != 1 {
+			return usagef("expected a shell: bash, zsh, or fish")
+		}
+		switch fs.Arg(0) {
+		case "bash":
+			return writeBashCompletion(os.Stdout)
+		case "zsh":
+			// zsh runs bash completion functions through bashcompinit.
+			fmt.Fprintln(os.Stdout, "autoload -U +X bashcompinit && bashcompinit")
+			return writeBashCompletion(os.Stdout)
+		case "fish":
+			return writeFishCompletion(os.Stdout)
+		}
+		return usagef("unsupported shell %q, want bash, zsh, or fish", fs.Arg(0))
+	}
+}
+
+// flagsOf returns the flags of the command.
+func flagsOf(c command) []*flag.Flag {
+	fs := flag.NewFlagSet(c.name, flag.ContinueOnError)
+	c.setup(fs)
+	var flags []*flag.Flag
+	fs.VisitAll(func(f *flag.Flag) {
+		flags = append(flags, f)
+	})
+	return flags
+}
+
+// argChoices returns the values the command's argument may take, if they
+// are a fixed set such as "bash|zsh|fish", or nil.
+func argChoices(c command) []string {
+	if c.args == "" || strings.ContainsAny(c.args, "<[ ") {
+		return nil
+	}
+	return strings.Split(c.args, "|")
+}
+
+// outputFormats are the values completed for -output.
+var outputFormats = []string{formatTable, formatJSON, formatCSV, formatYAML}
+
+func writeBashCompletion(w io.Writer) error {
+	var b strings.Builder
+	b.WriteString(`_digikey() {
+	local cur=${COMP_WORDS[COMP_CWORD]} prev=${COMP_WORDS[COMP_CWORD-1]}
+	local words="${COMP_WORDS[*]:1:COMP_CWORD-1}"
+	COMPREPLY=()
+	case $prev in
+	-output|--output)
+		COMPREPLY=($(compgen -W "` + strings.Join(outputFormats, " ") + `" -- "$cur"))
+		return ;;
+	esac
+	case $words in
+`)
+	// Complete the first word, and the second of multi-word commands.
+	subs := map[string][]string{"": nil}
+	var prefixes []string
+	for _, c := range commands {
+		words := strings.Fields(c.name)
+		for i := range words {
+			prefix := strings.Join(words[:i], " ")
+			if _, ok := subs[prefix]; !ok {
+				prefixes = append(prefixes, prefix)
+			}
+			if !slices.Contains(subs[prefix], words[i]) {
+				subs[prefix] = append(subs[prefix], words[i])
+			}
+		}
+	}
+	for _, prefix := range append([]string{""}, prefixes...) {
+		fmt.Fprintf(&b, "\t%q)\n\t\tCOMPREPLY=($(compgen -W %q -- \"$cur\")) ;;\n", prefix, strings.Join(subs[prefix], " "))
+	}
+	for _, c := range commands {
+		pattern := strings.ReplaceAll(c.name, " ", `\ `)
+		fmt.Fprintf(&b, "\t%s|%s\\ *)\n", pattern, pattern)
+		if choices := argChoices(c); choices != nil {
+			fmt.Fprintf(&b, "\t\t[[ $words == %s ]] && COMPREPLY=($(compgen -W %q -- \"$cur\")) ;;\n",
+				pattern, strings.Join(choices, " "))
+			continue
+		}
+		var names []string
+		for _, f := range flagsOf(c) {
+			names = append(names, flagName(f))
+		}
+		fmt.Fprintf(&b, "\t\t[[ $cur == -* ]] && COMPREPLY=($(compgen -W %q -- \"$cur\")) ;;\n", strings.Join(names, " "))
+	}
+	b.WriteString(`	esac
+}
+complete -o default -F _digikey digikey
+`)
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func writeFishCompletion(w io.Writer) error {
+	var b strings.Builder
+	for _, c := range commands {
+		words := strings.Fields(c.name)
+		// The condition under which the command's last word is completed,
+		// and under which its flags are.
+		cond := "__fish_use_subcommand"
+		if len(words) > 1 {
+			cond = "__fish_seen_subcommand_from " + words[0]
+		}
+		fmt.Fprintf(&b, "complete -c digikey -n %s -f -a %s -d %s\n",
+			fishQuote(cond), words[len(words)-1], fishQuote(c.summary))
+		flagCond := fishQuote("__fish_seen_subcommand_from " + words[len(words)-1])
+		if choices := argChoices(c); choices != nil {
+			fmt.Fprintf(&b, "complete -c digikey -n %s -f -a %s\n", flagCond, fishQuote(strings.Join(choices, " ")))
+		}
+		for _, f := range flagsOf(c) {
+			option := "-l"
+			if len(f.Name) == 1 {
+				option = "-s"
+			}
+			fmt.Fprintf(&b, "complete -c digikey -n %s %s %s", flagCond, option, f.Name)
+			if f.Name == "output" {
+				fmt.Fprintf(&b, " -x -a %s", fishQuote(strings.Join(outputFormats, " ")))
+			} else if !isBoolFlag(f) {
+				b.WriteString(" -r -F")
+			}
+			fmt.Fprintf(&b, " -d %s\n", fishQuote(f.Usage))
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// flagName returns the flag as completed: --name, or -n for single-letter
+// flags.
+func flagName(f *flag.Flag) string {
+	if len(f.Name) == 1 {
+		return "-" + f.Name
+	}
+	return "--" + f.Name
+}
+
+// isBoolFlag reports whether the flag takes no value.
+func isBoolFlag(f *flag.Flag) bool {
+	b, ok := f.Value.(interface{ IsBoolFlag() bool })
+	return ok && b.IsBoolFlag()
+}
+
+// fishQuote quotes s as a fish single-quoted string.
+func fishQuote(s string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(s) + "'"
+}
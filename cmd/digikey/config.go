@@ -0,0 +1,92 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/apidepot/digikey"
+)
+
+// config is the contents of the config file.
+type config struct {
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+	Sandbox      bool   `json:"sandbox"`
+	BaseURL      string `json:"base_url"`
+	TokenURL     string `json:"token_url"`
+}
+
+// clientFlags are the flags shared by commands that call the API.
+type clientFlags struct {
+	configPath string
+	sandbox    bool
+}
+
+func (f *clientFlags) register(flags *flag.FlagSet) {
+	flags.StringVar(&f.configPath, "config", "", "config file (default digikey/config.json in the user config directory)")
+	flags.BoolVar(&f.sandbox, "sandbox", false, "use the DigiKey sandbox")
+}
+
+// client creates a client from the environment and config file.
+func (f *clientFlags) client() (*digikey.Client, error) {
+	cfg, err := loadConfig(f.configPath)
+	if err != nil {
+		return nil, err
+	}
+	if id := os.Getenv("DIGIKEY_CLIENT_ID"); id != "" {
+		cfg.ClientID = id
+	}
+	if secret := os.Getenv("DIGIKEY_CLIENT_SECRET"); secret != "" {
+		cfg.ClientSecret = secret
+	}
+	if cfg.ClientID == "" || cfg.ClientSecret == "" {
+		return nil, errNoCredentials
+	}
+
+	var opts []digikey.ClientOption
+	if f.sandbox || cfg.Sandbox {
+		opts = append(opts, digikey.WithDefaultSandbox())
+	}
+	if cfg.BaseURL != "" {
+		opts = append(opts, digikey.WithBaseURL(cfg.BaseURL))
+	}
+	if cfg.TokenURL != "" {
+		opts = append(opts, digikey.WithTokenURL(cfg.TokenURL))
+	}
+	return digikey.NewClient(cfg.ClientID, cfg.ClientSecret, opts...)
+}
+
+// loadConfig reads the config file at path, or the default config file if
+// path is empty. A missing default config file is not an error.
+func loadConfig(path string) (config, error) {
+	var cfg config
+	explicit := path != ""
+	if !explicit {
+		dir, err := os.UserConfigDir()
+		if err != nil {
+			return cfg, nil
+		}
+		path = filepath.Join(dir, "digikey", "config.json")
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if !explicit && errors.Is(err, fs.ErrNotExist) {
+			return cfg, nil
+		}
+		return cfg, fmt.Errorf("error reading config file: %w", err)
+	}
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return cfg, fmt.Errorf("error parsing config file %s: %w", path, err)
+	}
+	return cfg, nil
+}
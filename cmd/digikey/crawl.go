@@ -0,0 +1,72 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/apidepot/digikey/crawl"
+)
+
+func setupCrawl(fs *flag.FlagSet) func(context.Context, *flag.FlagSet) error {
+	var cf clientFlags
+	cf.register(fs)
+	outPath := fs.String("o", "", "write the CSV table to this file instead of standard output")
+	checkpoint := fs.String("checkpoint", "", "save progress to this file and resume from it")
+	quiet := fs.Bool("quiet", false, "do not report progress")
+	return func(ctx context.Context, fs *flag.FlagSet) error {
+		if fs.NArg() != 1 {
+			return usagef("expected a single category ID")
+		}
+		categoryID, err := strconv.Atoi(fs.Arg(0))
+		if err != nil {
+			return usagef("invalid category ID %q", fs.Arg(0))
+		}
+
+		c, err := cf.client()
+		if err != nil {
+			return err
+		}
+		var opts []crawl.Option
+		if *checkpoint != "" {
+			opts = append(opts, crawl.WithCheckpoint(*checkpoint))
+		}
+		if !*quiet {
+			opts = append(opts, crawl.WithProgress(func(p crawl.Progress) {
+				fmt.Fprintf(os.Stderr, "crawled %d of %d products\n", p.Done, p.Total)
+			}))
+		}
+		products, err := crawl.New(c, opts...).Crawl(ctx, categoryID)
+		if err != nil {
+			if *checkpoint != "" {
+				return fmt.Errorf("%w (run again to resume)", err)
+			}
+			return err
+		}
+
+		var w io.Writer = os.Stdout
+		if *outPath != "" {
+			f, err := os.Create(*outPath)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			w = f
+		}
+		if err := crawl.NewCSVWriter(w).WriteTable(crawl.NewTable(products)); err != nil {
+			return err
+		}
+		if f, ok := w.(*os.File); ok && f != os.Stdout {
+			return f.Close()
+		}
+		return nil
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"net"
+	"net/http"
+
+	"github.com/apidepot/digikey"
+)
+
+// Exit codes, documented in the package comment. They are part of the
+// CLI's interface and must not change.
+const (
+	exitOK          = 0
+	exitError       = 1
+	exitUsage       = 2
+	exitAuth        = 3
+	exitNotFound    = 4
+	exitThrottled   = 5
+	exitUnavailable = 6
+	exitInterrupted = 130
+)
+
+// errNoCredentials is returned when neither the environment nor the config
+// file provide credentials.
+var errNoCredentials = errors.New("no credentials: set DIGIKEY_CLIENT_ID and DIGIKEY_CLIENT_SECRET or create a config file")
+
+// exitCode returns the exit code for the error a command returned.
+func exitCode(err error) int {
+	var (
+		ue       usageError
+		tokenErr *digikey.TokenError
+		apiErr   digikey.Error
+		netErr   net.Error
+	)
+	switch {
+	case err == nil, errors.Is(err, flag.ErrHelp):
+		return exitOK
+	case errors.As(err, &ue):
+		return exitUsage
+	case errors.Is(err, context.Canceled):
+		return exitInterrupted
+	case errors.Is(err, errNoCredentials), errors.As(err, &tokenErr):
+		return exitAuth
+	case errors.Is(err, digikey.ErrQuotaExceeded):
+		return exitThrottled
+	case errors.Is(err, digikey.ErrNoExactMatch):
+		return exitNotFound
+	case errors.Is(err, digikey.ErrCircuitOpen):
+		return exitUnavailable
+	case errors.As(err, &apiErr):
+		switch {
+		case apiErr.StatusCode == http.StatusUnauthorized, apiErr.StatusCode == http.StatusForbidden:
+			return exitAuth
+		case apiErr.StatusCode == http.StatusNotFound:
+			return exitNotFound
+		case apiErr.StatusCode == http.StatusTooManyRequests:
+			return exitThrottled
+		case apiErr.StatusCode >= 500:
+			return exitUnavailable
+		}
+	case errors.Is(err, context.DeadlineExceeded), errors.As(err, &netErr):
+		return exitUnavailable
+	}
+	return exitError
+}
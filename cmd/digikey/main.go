@@ -0,0 +1,151 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+// Digikey is a command line client for the DigiKey API.
+//
+// Usage:
+//
+//	digikey <command> [flags] [arguments]
+//
+// Credentials are read from the DIGIKEY_CLIENT_ID and DIGIKEY_CLIENT_SECRET
+// environment variables, falling back to the config file, by default
+// digikey/config.json in the user's config directory:
+//
+//	{"client_id": "...", "client_secret": "...", "sandbox": false}
+//
+// Commands that print results take -output table, json, csv, or yaml. The
+// exit status tells failures apart, so scripts can react to them:
+//
+//	0    success
+//	1    any other error
+//	2    invalid command, flags, or arguments
+//	3    missing or rejected credentials
+//	4    part, category, or other resource not found
+//	5    rate limit or daily quota reached
+//	6    DigiKey API unavailable or unreachable
+//	130  interrupted
+//
+// "digikey browse bom.csv" searches interactively in the terminal, adding
+// the parts chosen to the BOM file.
+//
+// Shell completions are printed by "digikey completion bash|zsh|fish".
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+)
+
+// command is a subcommand of the CLI.
+type command struct {
+	// name is the words that select the command, e.g. "bom quote".
+	name    string
+	args    string
+	summary string
+	// setup registers the command's flags and returns the function that
+	// runs the command once they are parsed.
+	setup func(fs *flag.FlagSet) func(ctx context.Context, fs *flag.FlagSet) error
+}
+
+var commands []command
+
+func init() {
+	commands = []command{
+		{"search", "<keywords>", "search for products by keyword", setupSearch},
+		{"part", "<DigiKey or manufacturer part number>", "show the details of a part", setupPart},
+		{"bom quote", "<bom.csv>", "quote a bill of materials", setupBOMQuote},
+		{"crawl", "<category ID>", "export the parametric table of a category as CSV", setupCrawl},
+		{"browse", "[bom.csv]", "search parts interactively, collecting them into a BOM", setupBrowse},
+		{"completion", "bash|zsh|fish", "print the shell completion script", setupCompletion},
+	}
+}
+
+// usageError is an error in the command, flags, or arguments given.
+type usageError struct {
+	err error
+}
+
+func (e usageError) Error() string { return e.err.Error() }
+
+func (e usageError) Unwrap() error { return e.err }
+
+// usagef returns a usage error with the formatted message.
+func usagef(format string, args ...any) error {
+	return usageError{fmt.Errorf(format, args...)}
+}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	if err := run(ctx, os.Args[1:]); err != nil {
+		if !errors.Is(err, flag.ErrHelp) {
+			fmt.Fprintln(os.Stderr, "digikey:", err)
+		}
+		os.Exit(exitCode(err))
+	}
+}
+
+func run(ctx context.Context, args []string) error {
+	if len(args) > 0 && (args[0] == "-h" || args[0] == "-help" || args[0] == "--help" || args[0] == "help") {
+		usage()
+		return flag.ErrHelp
+	}
+	if len(args) == 0 {
+		usage()
+		return usagef("no command")
+	}
+	c, rest, ok := lookup(args)
+	if !ok {
+		usage()
+		return usagef("unknown command %q", strings.Join(args[:min(len(args), 2)], " "))
+	}
+	fs := flag.NewFlagSet(c.name, flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "usage: digikey %s [flags] %s\n", c.name, c.args)
+		fs.PrintDefaults()
+	}
+	runCommand := c.setup(fs)
+	if err := fs.Parse(rest); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return err
+		}
+		// The flag set has printed the error and usage.
+		return usageError{err}
+	}
+	err := runCommand(ctx, fs)
+	var ue usageError
+	if errors.As(err, &ue) {
+		fs.Usage()
+	}
+	return err
+}
+
+// lookup returns the command selected by the leading arguments and the
+// remaining arguments.
+func lookup(args []string) (command, []string, bool) {
+	for _, c := range commands {
+		words := strings.Fields(c.name)
+		if len(args) >= len(words) && strings.Join(args[:len(words)], " ") == c.name {
+			return c, args[len(words):], true
+		}
+	}
+	return command{}, nil, false
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: digikey <command> [flags] [arguments]")
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "commands:")
+	for _, c := range commands {
+		fmt.Fprintf(os.Stderr, "  %-12s %s\n", c.name, c.summary)
+	}
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Run "digikey <command> -h" for the flags of a command.`)
+}
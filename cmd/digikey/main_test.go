@@ -0,0 +1,175 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/apidepot/digikey"
+	"github.com/apidepot/digikey/digikeytest"
+)
+
+func TestExitCode(t *testing.T) {
+	tests := []struct {
+		err  error
+		want int
+	}{
+		{nil, exitOK},
+		{flag.ErrHelp, exitOK},
+		{errors.New("boom"), exitError},
+		{usagef("no keywords"), exitUsage},
+		{checkFormat("xml", formatJSON), exitUsage},
+		{errNoCredentials, exitAuth},
+		{&digikey.TokenError{Err: errors.New("bad status code (401)"), Failures: 1, RetryAt: time.Now()}, exitAuth},
+		{digikey.Error{StatusCode: 401}, exitAuth},
+		{fmt.Errorf("error getting part: %w", digikey.Error{StatusCode: 404}), exitNotFound},
+		{digikey.ErrNoExactMatch, exitNotFound},
+		{digikey.Error{StatusCode: 429}, exitThrottled},
+		{digikey.ErrQuotaExceeded, exitThrottled},
+		{digikey.Error{StatusCode: 503}, exitUnavailable},
+		{digikey.Error{StatusCode: 400}, exitError},
+		{digikey.ErrCircuitOpen, exitUnavailable},
+		{&net.OpError{Op: "dial", Err: errors.New("connection refused")}, exitUnavailable},
+		{context.DeadlineExceeded, exitUnavailable},
+		{context.Canceled, exitInterrupted},
+	}
+	for _, tt := range tests {
+		if got := exitCode(tt.err); got != tt.want {
+			t.Errorf("exitCode(%v) = %d, want %d", tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestRunUsageErrors(t *testing.T) {
+	for _, args := range [][]string{
+		nil,
+		{"frobnicate"},
+		{"bom"},
+		{"search"},
+		{"search", "-no-such-flag", "lm358"},
+		{"part", "-output", "xml", "LM358DR"},
+		{"completion", "tcsh"},
+	} {
+		if got := exitCode(run(context.Background(), args)); got != exitUsage {
+			t.Errorf("run(%q) exit code = %d, want %d", args, got, exitUsage)
+		}
+	}
+}
+
+func TestLookup(t *testing.T) {
+	c, rest, ok := lookup([]string{"bom", "quote", "-qty", "10", "bom.csv"})
+	if !ok || c.name != "bom quote" || strings.Join(rest, " ") != "-qty 10 bom.csv" {
+		t.Errorf("lookup() = %q, %q, %v", c.name, rest, ok)
+	}
+}
+
+func TestCompletion(t *testing.T) {
+	var bash, fish bytes.Buffer
+	if err := writeBashCompletion(&bash); err != nil {
+		t.Fatal(err)
+	}
+	if err := writeFishCompletion(&fish); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{`"search part bom crawl browse completion"`, `"quote"`, "--in-stock", "--xlsx", " -o ", `"bash zsh fish"`} {
+		if !strings.Contains(bash.String(), want) {
+			t.Errorf("bash completion does not contain %s", want)
+		}
+	}
+	for _, want := range []string{"-a search", "-l in-stock", "-s o", "-a 'table json csv yaml'", `e.g. "cut tape,digi-reel"`} {
+		if !strings.Contains(fish.String(), want) {
+			t.Errorf("fish completion does not contain %s", want)
+		}
+	}
+}
+
+func TestWriteYAML(t *testing.T) {
+	var buf bytes.Buffer
+	v := struct {
+		MPN      string   `json:"mpn"`
+		Quantity int      `json:"quantity"`
+		Code     string   `json:"code"`
+		Tags     []string `json:"tags"`
+	}{"LM358DR", 10, "007", []string{"op amp"}}
+	if err := writeYAML(&buf, v); err != nil {
+		t.Fatal(err)
+	}
+	want := "mpn: LM358DR\nquantity: 10\ncode: \"007\"\ntags:\n  - op amp\n"
+	if buf.String() != want {
+		t.Errorf("writeYAML() = %q, want %q", buf.String(), want)
+	}
+}
+
+func TestRunAgainstFakeServer(t *testing.T) {
+	srv := digikeytest.NewServer("id", "secret")
+	defer srv.Close()
+	cfg := filepath.Join(t.TempDir(), "config.json")
+	data := fmt.Sprintf(`{"client_id":"id","client_secret":"secret","base_url":%q,"token_url":%q}`,
+		srv.URL+"/", srv.Tokens.TokenURL())
+	if err := os.WriteFile(cfg, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("DIGIKEY_CLIENT_ID", "")
+	t.Setenv("DIGIKEY_CLIENT_SECRET", "")
+
+	out, err := captureStdout(t, func() error {
+		return run(context.Background(), []string{"search", "-config", cfg, "-output", "csv", "LM358"})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(out, "digiKeyPartNumber,mpn,") || !strings.Contains(out, "LM358DR") {
+		t.Errorf("search -output csv = %q", out)
+	}
+
+	out, err = captureStdout(t, func() error {
+		return run(context.Background(), []string{"part", "-config", cfg, "-output", "yaml", "LM358DR"})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(out, "mpn: LM358DR\n") {
+		t.Errorf("part -output yaml = %q", out)
+	}
+
+	_, err = captureStdout(t, func() error {
+		return run(context.Background(), []string{"part", "-config", cfg, "NO-SUCH-PART"})
+	})
+	if got := exitCode(err); got != exitNotFound {
+		t.Errorf("part of unknown part: exit code %d (%v), want %d", got, err, exitNotFound)
+	}
+}
+
+// captureStdout returns what fn writes to standard output.
+func captureStdout(t *testing.T, fn func() error) (string, error) {
+	t.Helper()
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdout := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = stdout }()
+	done := make(chan string)
+	go func() {
+		data, _ := io.ReadAll(r)
+		done <- string(data)
+	}()
+	err = fn()
+	w.Close()
+	return <-done, err
+}
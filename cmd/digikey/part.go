@@ -0,0 +1,197 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/apidepot/digikey"
+)
+
+// partView is the summary of a product written by the part command.
+type partView struct {
+	ManufacturerProductNumber string          `json:"mpn"`
+	Manufacturer              string          `json:"manufacturer"`
+	Description               string          `json:"description"`
+	Status                    string          `json:"status"`
+	QuantityAvailable         int             `json:"quantityAvailable"`
+	LeadTime                  string          `json:"leadTime,omitempty"`
+	DatasheetURL              string          `json:"datasheetUrl,omitempty"`
+	ProductURL                string          `json:"productUrl,omitempty"`
+	Currency                  string          `json:"currency,omitempty"`
+	Parameters                []parameterView `json:"parameters"`
+	Packaging                 []packagingView `json:"packaging"`
+}
+
+type parameterView struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type packagingView struct {
+	DigiKeyPartNumber    string      `json:"digiKeyPartNumber"`
+	Packaging            string      `json:"packaging"`
+	QuantityAvailable    int         `json:"quantityAvailable"`
+	MinimumOrderQuantity int         `json:"minimumOrderQuantity"`
+	PriceBreaks          []breakView `json:"priceBreaks"`
+}
+
+type breakView struct {
+	Quantity  int     `json:"quantity"`
+	UnitPrice float64 `json:"unitPrice"`
+}
+
+func newPartView(p *digikey.Product) partView {
+	v := partView{
+		ManufacturerProductNumber: p.ManufacturerProductNumber,
+		Manufacturer:              p.Manufacturer.Name,
+		Description:               p.Description.ProductDescription,
+		Status:                    p.ProductStatus.Status,
+		QuantityAvailable:         p.QuantityAvailable,
+		LeadTime:                  p.ManufacturerLeadWeeks,
+		DatasheetURL:              p.DatasheetURL,
+		ProductURL:                p.ProductURL,
+		Currency:                  p.UnitPrice.Currency,
+		Parameters:                []parameterView{},
+		Packaging:                 []packagingView{},
+	}
+	for _, param := range p.Parameters {
+		v.Parameters = append(v.Parameters, parameterView{param.ParameterText, param.ValueText})
+	}
+	for _, pv := range p.ProductVariations {
+		pkg := packagingView{
+			DigiKeyPartNumber:    pv.DigiKeyProductNumber,
+			Packaging:            pv.PackageType.Name,
+			QuantityAvailable:    pv.QuantityAvailableForPackageType,
+			MinimumOrderQuantity: pv.MinimumOrderQuantity,
+			PriceBreaks:          []breakView{},
+		}
+		for _, b := range pv.StandardPricing {
+			pkg.PriceBreaks = append(pkg.PriceBreaks, breakView{b.BreakQuantity, b.UnitPrice.Amount})
+		}
+		v.Packaging = append(v.Packaging, pkg)
+	}
+	return v
+}
+
+func setupPart(fs *flag.FlagSet) func(context.Context, *flag.FlagSet) error {
+	var cf clientFlags
+	cf.register(fs)
+	output := fs.String("output", formatTable, "output format: table, json, csv, or yaml")
+	return func(ctx context.Context, fs *flag.FlagSet) error {
+		if fs.NArg() != 1 {
+			return usagef("expected a single part number")
+		}
+		if err := checkFormat(*output, formatTable, formatJSON, formatCSV, formatYAML); err != nil {
+			return err
+		}
+
+		c, err := cf.client()
+		if err != nil {
+			return err
+		}
+		p, err := c.Products.ProductDetails(ctx, fs.Arg(0))
+		if err != nil {
+			return err
+		}
+		v := newPartView(p)
+
+		switch *output {
+		case formatJSON:
+			return writeJSON(os.Stdout, v)
+		case formatYAML:
+			return writeYAML(os.Stdout, v)
+		case formatCSV:
+			return writePartCSV(os.Stdout, v)
+		}
+		return writePartTable(os.Stdout, v)
+	}
+}
+
+// writePartCSV writes the part as section, name, value records, so that
+// scripts can pick out fields without knowing the number of parameters or
+// packaging options.
+func writePartCSV(w io.Writer, v partView) error {
+	cw := csv.NewWriter(w)
+	records := [][]string{
+		{"section", "name", "value"},
+		{"part", "mpn", v.ManufacturerProductNumber},
+		{"part", "manufacturer", v.Manufacturer},
+		{"part", "description", v.Description},
+		{"part", "status", v.Status},
+		{"part", "quantityAvailable", strconv.Itoa(v.QuantityAvailable)},
+		{"part", "leadTime", v.LeadTime},
+		{"part", "datasheetUrl", v.DatasheetURL},
+		{"part", "productUrl", v.ProductURL},
+		{"part", "currency", v.Currency},
+	}
+	for _, p := range v.Parameters {
+		records = append(records, []string{"parameter", p.Name, p.Value})
+	}
+	for _, pkg := range v.Packaging {
+		for _, b := range pkg.PriceBreaks {
+			name := fmt.Sprintf("%s@%d", pkg.DigiKeyPartNumber, b.Quantity)
+			records = append(records, []string{"price", name, strconv.FormatFloat(b.UnitPrice, 'f', -1, 64)})
+		}
+	}
+	if err := cw.WriteAll(records); err != nil {
+		return err
+	}
+	return cw.Error()
+}
+
+func writePartTable(w io.Writer, v partView) error {
+	fields := [][]string{
+		{"MPN", v.ManufacturerProductNumber},
+		{"Manufacturer", v.Manufacturer},
+		{"Description", v.Description},
+		{"Status", v.Status},
+		{"Available", strconv.Itoa(v.QuantityAvailable)},
+		{"Lead Time", v.LeadTime},
+		{"Datasheet", v.DatasheetURL},
+		{"Product Page", v.ProductURL},
+	}
+	if err := writeTable(w, []string{"FIELD", "VALUE"}, fields); err != nil {
+		return err
+	}
+
+	if len(v.Parameters) > 0 {
+		fmt.Fprintln(w)
+		rows := make([][]string, 0, len(v.Parameters))
+		for _, p := range v.Parameters {
+			rows = append(rows, []string{p.Name, p.Value})
+		}
+		if err := writeTable(w, []string{"PARAMETER", "VALUE"}, rows); err != nil {
+			return err
+		}
+	}
+
+	if len(v.Packaging) > 0 {
+		fmt.Fprintln(w)
+		var rows [][]string
+		for _, pkg := range v.Packaging {
+			for _, b := range pkg.PriceBreaks {
+				rows = append(rows, []string{
+					pkg.DigiKeyPartNumber,
+					pkg.Packaging,
+					strconv.Itoa(pkg.QuantityAvailable),
+					strconv.Itoa(b.Quantity),
+					formatPrice(digikey.NewMoney(b.UnitPrice, v.Currency)),
+				})
+			}
+		}
+		if err := writeTable(w, []string{"DIGIKEY PN", "PACKAGING", "AVAILABLE", "BREAK", "UNIT PRICE"}, rows); err != nil {
+			return err
+		}
+	}
+	return nil
+}
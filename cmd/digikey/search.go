@@ -0,0 +1,172 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/apidepot/digikey"
+)
+
+// sortOrders maps the values of the -sort flag to sort orders.
+var sortOrders = map[string]digikey.SortOptions{
+	"price":        digikey.ByUnitPriceAsc,
+	"-price":       digikey.ByUnitPriceDesc,
+	"stock":        digikey.ByQuantityAvailableDesc,
+	"manufacturer": digikey.ByManufacturerAsc,
+	"dkpn":         digikey.ByDigiKeyPartNumberAsc,
+	"mpn":          digikey.ByManufacturerPartNumberAsc,
+}
+
+func setupSearch(fs *flag.FlagSet) func(context.Context, *flag.FlagSet) error {
+	var cf clientFlags
+	cf.register(fs)
+	inStock := fs.Bool("in-stock", false, "only products in stock")
+	minQty := fs.Int("min-qty", 0, "only products with at least this many units in stock")
+	normallyStocking := fs.Bool("normally-stocking", false, "only products DigiKey normally stocks")
+	noMarketPlace := fs.Bool("no-marketplace", false, "exclude marketplace products")
+	manufacturers := fs.String("manufacturer", "", "comma-separated manufacturer names or IDs")
+	categories := fs.String("category", "", "comma-separated category IDs")
+	packaging := fs.String("packaging", "", "comma-separated packaging types, e.g. \"cut tape,digi-reel\"")
+	sort := fs.String("sort", "", "sort order: price, -price, stock, manufacturer, dkpn, or mpn")
+	limit := fs.Int("limit", 25, "maximum number of products")
+	offset := fs.Int("offset", 0, "number of products to skip")
+	output := fs.String("output", formatTable, "output format: table, json, csv, or yaml")
+	return func(ctx context.Context, fs *flag.FlagSet) error {
+		if fs.NArg() == 0 {
+			return usagef("no keywords")
+		}
+		if err := checkFormat(*output, formatTable, formatJSON, formatCSV, formatYAML); err != nil {
+			return err
+		}
+
+		req := digikey.NewSearchRequest(strings.Join(fs.Args(), " ")).Limit(*limit).Offset(*offset)
+		if *inStock {
+			req.InStockOnly()
+		}
+		if *minQty > 0 {
+			req.MinimumQuantityAvailable(*minQty)
+		}
+		if *normallyStocking {
+			req.NormallyStocking()
+		}
+		if *noMarketPlace {
+			req.ExcludeMarketPlace()
+		}
+		for _, m := range strings.Split(*manufacturers, ",") {
+			if m = strings.TrimSpace(m); m != "" {
+				req.Manufacturer(m)
+			}
+		}
+		ids, err := parseIDs(*categories)
+		if err != nil {
+			return usagef("invalid -category: %w", err)
+		}
+		req.Category(ids...)
+		for _, name := range strings.Split(*packaging, ",") {
+			if strings.TrimSpace(name) == "" {
+				continue
+			}
+			t, err := digikey.ParsePackagingType(name)
+			if err != nil {
+				return usagef("invalid -packaging: %w", err)
+			}
+			req.PackagingTypes(t)
+		}
+		if *sort != "" {
+			s, ok := sortOrders[*sort]
+			if !ok {
+				return usagef("unknown sort order %q", *sort)
+			}
+			req.Sort(s)
+		}
+
+		c, err := cf.client()
+		if err != nil {
+			return err
+		}
+		resp, err := c.Products.KeywordSearch(ctx, req.Build())
+		if err != nil {
+			return err
+		}
+
+		switch *output {
+		case formatJSON:
+			return writeJSON(os.Stdout, resp)
+		case formatYAML:
+			return writeYAML(os.Stdout, resp)
+		case formatCSV:
+			return writeSearchCSV(os.Stdout, resp.Products)
+		}
+		rows := make([][]string, 0, len(resp.Products))
+		for _, p := range resp.Products {
+			rows = append(rows, []string{
+				digiKeyPartNumber(p),
+				p.ManufacturerProductNumber,
+				p.Manufacturer.Name,
+				strconv.Itoa(p.QuantityAvailable),
+				formatPrice(p.UnitPrice),
+				truncate(p.Description.ProductDescription, 50),
+			})
+		}
+		header := []string{"DIGIKEY PN", "MPN", "MANUFACTURER", "AVAILABLE", "UNIT PRICE", "DESCRIPTION"}
+		if err := writeTable(os.Stdout, header, rows); err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "%d of %d products\n", len(resp.Products), resp.ProductsCount)
+		return nil
+	}
+}
+
+// writeSearchCSV writes a record per product, with untruncated
+// descriptions and prices without their currency, which has a column.
+func writeSearchCSV(w io.Writer, products []digikey.Product) error {
+	header := []string{"digiKeyPartNumber", "mpn", "manufacturer", "quantityAvailable", "unitPrice", "currency", "description"}
+	rows := make([][]string, 0, len(products))
+	for _, p := range products {
+		rows = append(rows, []string{
+			digiKeyPartNumber(p),
+			p.ManufacturerProductNumber,
+			p.Manufacturer.Name,
+			strconv.Itoa(p.QuantityAvailable),
+			strconv.FormatFloat(p.UnitPrice.Amount, 'f', -1, 64),
+			p.UnitPrice.Currency,
+			p.Description.ProductDescription,
+		})
+	}
+	return writeCSV(w, header, rows)
+}
+
+// parseIDs parses a comma-separated list of integer IDs.
+func parseIDs(s string) ([]int, error) {
+	if s == "" {
+		return nil, nil
+	}
+	var ids []int
+	for _, f := range strings.Split(s, ",") {
+		id, err := strconv.Atoi(strings.TrimSpace(f))
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// digiKeyPartNumber returns the DigiKey product number of the product's
+// first packaging option, or the empty string if it has none.
+func digiKeyPartNumber(p digikey.Product) string {
+	if len(p.ProductVariations) == 0 {
+		return ""
+	}
+	return p.ProductVariations[0].DigiKeyProductNumber
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import (
+	"context"
+	"fmt"
+	"slices"
+)
+
+// Comparison aligns the parameters of several products, one column per
+// product and one row per parameter, e.g. to choose between candidate
+// parts.
+type Comparison struct {
+	// Products are the columns, in the order of the part numbers compared.
+	Products []*Product
+	// Rows are the parameters: first those every product has, in the order
+	// of the first product, then the others, those most products have
+	// first.
+	Rows []ComparisonRow
+}
+
+// ComparisonRow is a parameter of the compared products.
+type ComparisonRow struct {
+	ParameterID int
+	Name        string
+	// Values are the values of the parameter for each product, in column
+	// order, or "" for a product without the parameter.
+	Values []string
+	// Common reports whether every product has the parameter.
+	Common bool
+	// Differs reports whether the products' values are not all the same.
+	Differs bool
+}
+
+// Compare looks up the products with the given DigiKey or manufacturer
+// product numbers and aligns their parameters into a comparison. It fails
+// if any product cannot be looked up. The options apply to every lookup.
+func (s *ProductsService) Compare(ctx context.Context, partNumbers []string, opts ...RequestOption) (*Comparison, error) {
+	c := &Comparison{Products: make([]*Product, len(partNumbers))}
+	for i, pn := range partNumbers {
+		p, err := s.ProductDetails(ctx, pn, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("error comparing %s: %w", pn, err)
+		}
+		c.Products[i] = p
+	}
+	c.Rows = compareParameters(c.Products)
+	return c, nil
+}
+
+// compareParameters returns the comparison rows of the products'
+// parameters.
+func compareParameters(products []*Product) []ComparisonRow {
+	var rows []ComparisonRow
+	index := make(map[int]int)
+	counts := make(map[int]int)
+	for col, p := range products {
+		seen := make(map[int]bool)
+		for _, param := range p.Parameters {
+			i, ok := index[param.ParameterID]
+			if !ok {
+				i = len(rows)
+				index[param.ParameterID] = i
+				rows = append(rows, ComparisonRow{
+					ParameterID: param.ParameterID,
+					Name:        param.ParameterText,
+					Values:      make([]string, len(products)),
+				})
+			}
+			if !seen[param.ParameterID] {
+				seen[param.ParameterID] = true
+				counts[param.ParameterID]++
+			}
+			rows[i].Values[col] = param.ValueText
+		}
+	}
+	for i := range rows {
+		r := &rows[i]
+		r.Common = counts[r.ParameterID] == len(products)
+		r.Differs = slices.ContainsFunc(r.Values, func(v string) bool { return v != r.Values[0] })
+	}
+	// Rows are in first-seen order, so a stable sort by the number of
+	// products having each keeps the common ones in the first product's
+	// order.
+	slices.SortStableFunc(rows, func(a, b ComparisonRow) int {
+		return counts[b.ParameterID] - counts[a.ParameterID]
+	})
+	return rows
+}
+
+// Differences returns the rows whose values differ between the products.
+func (c *Comparison) Differences() []ComparisonRow {
+	var rows []ComparisonRow
+	for _, r := range c.Rows {
+		if r.Differs {
+			rows = append(rows, r)
+		}
+	}
+	return rows
+}
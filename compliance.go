@@ -0,0 +1,123 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import "strings"
+
+// RoHSStatus is the RoHS classification of a product.
+type RoHSStatus int
+
+// RoHS classifications.
+const (
+	RoHSUnknown RoHSStatus = iota
+	RoHSCompliant
+	RoHS3Compliant
+	RoHSCompliantByExemption
+	RoHSNonCompliant
+	RoHSNotApplicable
+)
+
+var rohsStatusNames = map[RoHSStatus]string{
+	RoHSUnknown:              "Unknown",
+	RoHSCompliant:            "RoHS Compliant",
+	RoHS3Compliant:           "ROHS3 Compliant",
+	RoHSCompliantByExemption: "RoHS Compliant by Exemption",
+	RoHSNonCompliant:         "RoHS non-compliant",
+	RoHSNotApplicable:        "Not applicable",
+}
+
+// ParseRoHSStatus parses the RohsStatus classification returned by DigiKey,
+// e.g. "ROHS3 Compliant". Unrecognized values, including "Vendor
+// undefined", parse as RoHSUnknown.
+func ParseRoHSStatus(s string) RoHSStatus {
+	s = strings.ToLower(strings.TrimSpace(s))
+	switch {
+	case strings.Contains(s, "not applicable"):
+		return RoHSNotApplicable
+	case strings.Contains(s, "non") || strings.Contains(s, "not compliant"):
+		return RoHSNonCompliant
+	case strings.Contains(s, "exemption"):
+		return RoHSCompliantByExemption
+	case strings.Contains(s, "rohs3"):
+		return RoHS3Compliant
+	case strings.Contains(s, "compliant"):
+		return RoHSCompliant
+	default:
+		return RoHSUnknown
+	}
+}
+
+// String implements fmt.Stringer.
+func (s RoHSStatus) String() string {
+	if name, ok := rohsStatusNames[s]; ok {
+		return name
+	}
+	return rohsStatusNames[RoHSUnknown]
+}
+
+// Compliant reports whether the status is any form of RoHS compliance.
+func (s RoHSStatus) Compliant() bool {
+	return s == RoHSCompliant || s == RoHS3Compliant || s == RoHSCompliantByExemption
+}
+
+// ReachStatus is the REACH classification of a product.
+type ReachStatus int
+
+// REACH classifications.
+const (
+	ReachUnknown ReachStatus = iota
+	ReachUnaffected
+	ReachAffected
+	ReachNotApplicable
+)
+
+var reachStatusNames = map[ReachStatus]string{
+	ReachUnknown:       "Unknown",
+	ReachUnaffected:    "REACH Unaffected",
+	ReachAffected:      "REACH Affected",
+	ReachNotApplicable: "Not applicable",
+}
+
+// ParseReachStatus parses the ReachStatus classification returned by
+// DigiKey, e.g. "REACH Unaffected". Unrecognized values, including "Vendor
+// undefined", parse as ReachUnknown.
+func ParseReachStatus(s string) ReachStatus {
+	s = strings.ToLower(strings.TrimSpace(s))
+	switch {
+	case strings.Contains(s, "not applicable"):
+		return ReachNotApplicable
+	case strings.Contains(s, "unaffected"):
+		return ReachUnaffected
+	case strings.Contains(s, "affected"):
+		return ReachAffected
+	default:
+		return ReachUnknown
+	}
+}
+
+// String implements fmt.Stringer.
+func (s ReachStatus) String() string {
+	if name, ok := reachStatusNames[s]; ok {
+		return name
+	}
+	return reachStatusNames[ReachUnknown]
+}
+
+// RoHSStatus returns the parsed RoHS classification of the product.
+func (p Product) RoHSStatus() RoHSStatus {
+	return ParseRoHSStatus(p.Classifications.RohsStatus)
+}
+
+// IsRoHSCompliant reports whether the product is RoHS compliant, including
+// compliance by exemption.
+func (p Product) IsRoHSCompliant() bool {
+	return p.RoHSStatus().Compliant()
+}
+
+// ReachStatus returns the parsed REACH classification of the product.
+func (p Product) ReachStatus() ReachStatus {
+	return ParseReachStatus(p.Classifications.ReachStatus)
+}
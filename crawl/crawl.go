@@ -0,0 +1,283 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+// Package crawl walks every product in a DigiKey category and exports their
+// parameters as a table, one row per product and one column per parameter,
+// e.g. for a component-selection spreadsheet.
+//
+// A crawl pages through the category's keyword search results. Pages are
+// appended to a checkpoint file as they arrive, so a crawl interrupted by
+// cancellation, an error, or an exhausted daily quota resumes from the last
+// complete page when run again with the same checkpoint.
+package crawl
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/apidepot/digikey"
+)
+
+// DefaultMaxWait is the longest the crawler waits for the API to stop
+// throttling requests before giving up, unless changed with WithMaxWait.
+const DefaultMaxWait = 5 * time.Minute
+
+// Progress reports the state of a crawl after each page.
+type Progress struct {
+	CategoryID int
+	// Done is the number of products crawled so far, including those read
+	// from the checkpoint.
+	Done int
+	// Total is the number of products in the category, as last reported
+	// by DigiKey.
+	Total int
+}
+
+// Crawler walks the products of categories.
+type Crawler struct {
+	client      *digikey.Client
+	pageSize    int
+	checkpoint  string
+	maxWait     time.Duration
+	requestOpts []digikey.RequestOption
+	progress    func(Progress)
+}
+
+// Option applies an option to a crawler.
+type Option func(*Crawler)
+
+// WithPageSize sets the number of products requested per page, at most
+// digikey.MaxSearchLimit, which is the default.
+func WithPageSize(n int) Option {
+	return func(cr *Crawler) {
+		cr.pageSize = n
+	}
+}
+
+// WithCheckpoint sets the file that pages are saved to as they arrive and
+// that an interrupted crawl resumes from. The file is kept once the crawl
+// completes, so running it again reads the products from the file rather
+// than from DigiKey; remove the file to crawl afresh.
+func WithCheckpoint(path string) Option {
+	return func(cr *Crawler) {
+		cr.checkpoint = path
+	}
+}
+
+// WithMaxWait sets the longest the crawler waits for the API to stop
+// throttling requests. A throttled request whose response asks for a longer
+// wait, such as when DigiKey's daily limit is reached, stops the crawl.
+func WithMaxWait(d time.Duration) Option {
+	return func(cr *Crawler) {
+		cr.maxWait = d
+	}
+}
+
+// WithRequestOptions sets request options applied to every search.
+func WithRequestOptions(opts ...digikey.RequestOption) Option {
+	return func(cr *Crawler) {
+		cr.requestOpts = append(cr.requestOpts, opts...)
+	}
+}
+
+// WithProgress sets a function called after each page.
+func WithProgress(fn func(Progress)) Option {
+	return func(cr *Crawler) {
+		cr.progress = fn
+	}
+}
+
+// New creates a crawler that searches using the client. Searches are
+// subject to the client's rate limiter and quota.
+func New(c *digikey.Client, opts ...Option) *Crawler {
+	cr := &Crawler{
+		client:   c,
+		pageSize: digikey.MaxSearchLimit,
+		maxWait:  DefaultMaxWait,
+	}
+	for _, opt := range opts {
+		opt(cr)
+	}
+	return cr
+}
+
+// page is a page of products as saved to the checkpoint file.
+type page struct {
+	CategoryID int               `json:"categoryId"`
+	Offset     int               `json:"offset"`
+	Total      int               `json:"total"`
+	Products   []digikey.Product `json:"products"`
+}
+
+// Crawl returns every product in the category and its subcategories. A
+// throttled request is retried after the wait the API asks for, up to the
+// maximum wait. If the crawl stops early, the products crawled so far are
+// returned with the error, and are kept in the checkpoint file if one is
+// set.
+func (cr *Crawler) Crawl(ctx context.Context, categoryID int) ([]digikey.Product, error) {
+	p := digikey.FirstPage(cr.pageSize)
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	pages, err := cr.resume(categoryID)
+	if err != nil {
+		return nil, err
+	}
+	total := -1
+	for _, pg := range pages {
+		p.Offset = pg.Offset + len(pg.Products)
+		total = pg.Total
+	}
+	var save *json.Encoder
+	if cr.checkpoint != "" {
+		f, err := os.OpenFile(cr.checkpoint, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+		if err != nil {
+			return nil, fmt.Errorf("error opening checkpoint: %w", err)
+		}
+		defer f.Close()
+		save = json.NewEncoder(f)
+	}
+
+	if total >= 0 && p.Offset >= total {
+		return products(pages), nil
+	}
+	// Look up the subcategories once rather than for every page.
+	var cat *digikey.Category
+	err = cr.retry(ctx, func(opts []digikey.RequestOption) (err error) {
+		cat, err = cr.client.Products.CategoryByID(ctx, categoryID, opts...)
+		return err
+	})
+	if err != nil {
+		return products(pages), fmt.Errorf("error crawling category %d: %w", categoryID, err)
+	}
+	req := digikey.NewSearchRequest("").Category(cat.IDs()...)
+	for total < 0 || p.Offset < total {
+		var resp *digikey.KeywordResponse
+		err := cr.retry(ctx, func(opts []digikey.RequestOption) (err error) {
+			resp, err = cr.client.Products.KeywordSearch(ctx, req.Page(p).Build(), opts...)
+			return err
+		})
+		if err != nil {
+			return products(pages), fmt.Errorf("error crawling category %d at offset %d: %w", categoryID, p.Offset, err)
+		}
+		pg := page{CategoryID: categoryID, Offset: p.Offset, Total: resp.ProductsCount, Products: resp.Products}
+		if save != nil {
+			if err := save.Encode(pg); err != nil {
+				return products(pages), fmt.Errorf("error writing checkpoint: %w", err)
+			}
+		}
+		pages = append(pages, pg)
+		total = resp.ProductsCount
+		p.Offset += len(resp.Products)
+		if cr.progress != nil {
+			cr.progress(Progress{CategoryID: categoryID, Done: p.Offset, Total: total})
+		}
+		if len(resp.Products) == 0 {
+			// The category shrank while it was being crawled.
+			break
+		}
+	}
+	return products(pages), nil
+}
+
+// Export crawls the category and writes the parametric table of its
+// products. Nothing is written unless the crawl completes.
+func (cr *Crawler) Export(ctx context.Context, categoryID int, w Writer) error {
+	products, err := cr.Crawl(ctx, categoryID)
+	if err != nil {
+		return err
+	}
+	if err := w.WriteTable(NewTable(products)); err != nil {
+		return fmt.Errorf("error writing table: %w", err)
+	}
+	return nil
+}
+
+// retry calls fn with the crawler's request options, waiting and calling it
+// again while the API throttles requests.
+func (cr *Crawler) retry(ctx context.Context, fn func(opts []digikey.RequestOption) error) error {
+	var waited time.Duration
+	for {
+		err := fn(cr.requestOpts)
+		wait, ok := digikey.RetryAfter(err)
+		if !ok {
+			return err
+		}
+		if waited+wait > cr.maxWait {
+			return err
+		}
+		waited += wait
+		t := time.NewTimer(wait)
+		select {
+		case <-t.C:
+		case <-ctx.Done():
+			t.Stop()
+			return ctx.Err()
+		}
+	}
+}
+
+// resume reads the pages saved to the checkpoint file. A page cut short by
+// an interrupted write is dropped from the file.
+func (cr *Crawler) resume(categoryID int) ([]page, error) {
+	if cr.checkpoint == "" {
+		return nil, nil
+	}
+	f, err := os.OpenFile(cr.checkpoint, os.O_RDWR, 0)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error opening checkpoint: %w", err)
+	}
+	defer f.Close()
+	var pages []page
+	var good int64
+	dec := json.NewDecoder(bufio.NewReader(f))
+	for {
+		var pg page
+		err := dec.Decode(&pg)
+		if err == io.EOF {
+			return pages, nil
+		}
+		if err != nil {
+			// Keep the complete pages and crawl the rest again.
+			if err := f.Truncate(good); err != nil {
+				return nil, fmt.Errorf("error repairing checkpoint: %w", err)
+			}
+			return pages, nil
+		}
+		if pg.CategoryID != categoryID {
+			return nil, fmt.Errorf("checkpoint %s is for category %d, not %d", cr.checkpoint, pg.CategoryID, categoryID)
+		}
+		pages = append(pages, pg)
+		good = dec.InputOffset()
+	}
+}
+
+// products returns the products of the pages, dropping those seen on an
+// earlier page, which happens when the category changes during a crawl.
+func products(pages []page) []digikey.Product {
+	var all []digikey.Product
+	seen := make(map[string]bool)
+	for _, pg := range pages {
+		for _, p := range pg.Products {
+			key := strconv.Itoa(p.Manufacturer.ID) + "/" + p.ManufacturerProductNumber
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			all = append(all, p)
+		}
+	}
+	return all
+}
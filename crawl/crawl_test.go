@@ -0,0 +1,159 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package crawl
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/apidepot/digikey"
+	"github.com/apidepot/digikey/digikeytest"
+)
+
+// opAmps is the category crawled by the tests.
+var opAmps = digikey.Category{CategoryID: 42, Name: "Op Amps"}
+
+// newTestServer returns a fake server whose catalog has n products in
+// opAmps, OPAMP-1 to OPAMP-n, and one product in another category.
+func newTestServer(t *testing.T, n int) (*digikeytest.Server, *digikey.Client) {
+	t.Helper()
+	var products []digikey.Product
+	for i := range n {
+		p := digikey.Product{
+			ManufacturerProductNumber: fmt.Sprintf("OPAMP-%d", i+1),
+			Manufacturer:              digikey.Manufacturer{ID: 296, Name: "Texas Instruments"},
+			Category:                  opAmps,
+			QuantityAvailable:         100 * (i + 1),
+			Parameters: []digikey.Parameter{
+				{ParameterID: 1, ParameterText: "Voltage", ValueText: fmt.Sprintf("%dV", 3+i)},
+			},
+		}
+		if i%2 == 0 {
+			p.Parameters = append(p.Parameters, digikey.Parameter{ParameterID: 2, ParameterText: "Channels", ValueText: "2"})
+		}
+		products = append(products, p)
+	}
+	products = append(products, digikey.Product{
+		ManufacturerProductNumber: "RESISTOR",
+		Category:                  digikey.Category{CategoryID: 52, Name: "Resistors"},
+	})
+	srv := digikeytest.NewServer("id", "secret", products...)
+	t.Cleanup(srv.Close)
+	c, err := srv.NewClient("id", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return srv, c
+}
+
+// mpns returns the manufacturer product numbers of the products.
+func mpns(products []digikey.Product) []string {
+	var s []string
+	for _, p := range products {
+		s = append(s, p.ManufacturerProductNumber)
+	}
+	return s
+}
+
+func TestExport(t *testing.T) {
+	srv, c := newTestServer(t, 5)
+	var progress []Progress
+	cr := New(c, WithPageSize(2), WithProgress(func(p Progress) { progress = append(progress, p) }))
+	var buf bytes.Buffer
+	if err := cr.Export(context.Background(), opAmps.CategoryID, NewCSVWriter(&buf)); err != nil {
+		t.Fatal(err)
+	}
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := append(slices.Clone(productColumns), "Voltage", "Channels"); !slices.Equal(records[0], want) {
+		t.Errorf("header = %q, want %q", records[0], want)
+	}
+	if len(records) != 6 {
+		t.Fatalf("exported %d rows, want 5", len(records)-1)
+	}
+	for i, row := range records[1:] {
+		channels := ""
+		if i%2 == 0 {
+			channels = "2"
+		}
+		if row[1] != fmt.Sprintf("OPAMP-%d", i+1) || row[7] != fmt.Sprint(100*(i+1)) || row[10] != fmt.Sprintf("%dV", 3+i) || row[11] != channels {
+			t.Errorf("row %d = %q", i+1, row)
+		}
+	}
+	want := []Progress{{42, 2, 5}, {42, 4, 5}, {42, 5, 5}}
+	if !slices.Equal(progress, want) {
+		t.Errorf("progress = %v, want %v", progress, want)
+	}
+	srv.AssertRequested(t, http.MethodPost, "/products/v4/search/keyword", 3)
+}
+
+func TestCrawlResume(t *testing.T) {
+	srv, c := newTestServer(t, 5)
+	checkpoint := filepath.Join(t.TempDir(), "crawl.jsonl")
+	ctx := context.Background()
+
+	// The category lookup and first page succeed; the second page fails.
+	cr := New(c, WithPageSize(2), WithCheckpoint(checkpoint))
+	cr.progress = func(p Progress) {
+		if p.Done == 2 {
+			srv.FailNext(http.StatusInternalServerError, "oops")
+		}
+	}
+	got, err := cr.Crawl(ctx, opAmps.CategoryID)
+	if err == nil || !slices.Equal(mpns(got), []string{"OPAMP-1", "OPAMP-2"}) {
+		t.Fatalf("interrupted Crawl() = %v, %v, want the first page and an error", mpns(got), err)
+	}
+
+	// A page cut short by an interrupted write is dropped.
+	f, err := os.OpenFile(checkpoint, os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.WriteString(`{"categoryId":42,"offset":2,"tot`)
+	f.Close()
+
+	cr.progress = nil
+	n := len(srv.Requests())
+	got, err = cr.Crawl(ctx, opAmps.CategoryID)
+	want := []string{"OPAMP-1", "OPAMP-2", "OPAMP-3", "OPAMP-4", "OPAMP-5"}
+	if err != nil || !slices.Equal(mpns(got), want) {
+		t.Fatalf("resumed Crawl() = %v, %v, want %v", mpns(got), err, want)
+	}
+	if searches := len(srv.Requests()) - n - 1; searches != 2 {
+		t.Errorf("resumed crawl searched %d pages, want the 2 left", searches)
+	}
+
+	// A complete checkpoint answers without requests.
+	n = len(srv.Requests())
+	if got, err = cr.Crawl(ctx, opAmps.CategoryID); err != nil || !slices.Equal(mpns(got), want) || len(srv.Requests()) != n {
+		t.Errorf("Crawl() of a complete checkpoint = %v, %v after %d requests, want %v without requests", mpns(got), err, len(srv.Requests())-n, want)
+	}
+	if _, err := cr.Crawl(ctx, 52); err == nil {
+		t.Error("Crawl() of another category with the checkpoint succeeded")
+	}
+}
+
+func TestCrawlThrottled(t *testing.T) {
+	srv, c := newTestServer(t, 5)
+	cr := New(c, WithMaxWait(time.Second))
+	srv.FailNext(http.StatusTooManyRequests, "daily limit reached")
+	start := time.Now()
+	_, err := cr.Crawl(context.Background(), opAmps.CategoryID)
+	if _, ok := digikey.RetryAfter(err); !ok || time.Since(start) > time.Second {
+		t.Errorf("Crawl() asked to wait longer than the maximum = %v after %s, want the 429 error at once", err, time.Since(start))
+	}
+}
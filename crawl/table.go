@@ -0,0 +1,145 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package crawl
+
+import (
+	"encoding/csv"
+	"io"
+	"slices"
+	"strconv"
+
+	"github.com/apidepot/digikey"
+)
+
+// productColumns are the leading columns of a parametric table, before the
+// parameters.
+var productColumns = []string{
+	"DigiKey Product Number",
+	"Manufacturer Product Number",
+	"Manufacturer",
+	"Description",
+	"Category",
+	"Series",
+	"Product Status",
+	"Quantity Available",
+	"Unit Price",
+	"Datasheet",
+}
+
+// Table is a parametric table: one row per product, with the products'
+// common fields followed by one column per parameter.
+type Table struct {
+	Columns []string
+	Rows    [][]string
+}
+
+// NewTable returns the parametric table of the products. Parameter columns
+// are ordered by the number of products having them, those most have
+// first, and otherwise by first appearance. A product without a parameter
+// has an empty cell in its column.
+func NewTable(products []digikey.Product) *Table {
+	type column struct {
+		name  string
+		count int
+	}
+	var params []column
+	index := make(map[int]int)
+	for _, p := range products {
+		seen := make(map[int]bool)
+		for _, param := range p.Parameters {
+			if seen[param.ParameterID] {
+				continue
+			}
+			seen[param.ParameterID] = true
+			i, ok := index[param.ParameterID]
+			if !ok {
+				i = len(params)
+				index[param.ParameterID] = i
+				params = append(params, column{name: param.ParameterText})
+			}
+			params[i].count++
+		}
+	}
+	order := make([]int, len(params))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		return params[b].count - params[a].count
+	})
+	// position maps a parameter's first-seen index to its column.
+	position := make([]int, len(params))
+	t := &Table{Columns: slices.Clone(productColumns)}
+	for col, i := range order {
+		position[i] = len(productColumns) + col
+		t.Columns = append(t.Columns, params[i].name)
+	}
+
+	for _, p := range products {
+		row := make([]string, len(t.Columns))
+		copy(row, productRow(p))
+		for _, param := range p.Parameters {
+			if col := position[index[param.ParameterID]]; row[col] == "" {
+				row[col] = param.ValueText
+			}
+		}
+		t.Rows = append(t.Rows, row)
+	}
+	return t
+}
+
+// productRow returns the leading cells of the product's row.
+func productRow(p digikey.Product) []string {
+	var dkpn string
+	if len(p.ProductVariations) > 0 {
+		dkpn = p.ProductVariations[0].DigiKeyProductNumber
+	}
+	var price string
+	if !p.UnitPrice.IsZero() {
+		price = strconv.FormatFloat(p.UnitPrice.Amount, 'f', -1, 64)
+	}
+	return []string{
+		dkpn,
+		p.ManufacturerProductNumber,
+		p.Manufacturer.Name,
+		p.Description.ProductDescription,
+		p.Category.Name,
+		p.Series.Name,
+		p.ProductStatus.Status,
+		strconv.Itoa(p.QuantityAvailable),
+		price,
+		p.DatasheetURL,
+	}
+}
+
+// Writer writes a parametric table in some file format. Only CSVWriter is
+// provided so far; Parquet output, which the crawler was meant to offer
+// alongside CSV, is not implemented yet.
+type Writer interface {
+	WriteTable(t *Table) error
+}
+
+// CSVWriter writes tables as CSV with a header row.
+type CSVWriter struct {
+	w io.Writer
+}
+
+// NewCSVWriter returns a writer writing CSV to w.
+func NewCSVWriter(w io.Writer) *CSVWriter {
+	return &CSVWriter{w: w}
+}
+
+// WriteTable implements Writer.
+func (cw *CSVWriter) WriteTable(t *Table) error {
+	w := csv.NewWriter(cw.w)
+	if err := w.Write(t.Columns); err != nil {
+		return err
+	}
+	if err := w.WriteAll(t.Rows); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package crawl
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/apidepot/digikey"
+)
+
+func TestNewTable(t *testing.T) {
+	param := func(id int, name, value string) digikey.Parameter {
+		return digikey.Parameter{ParameterID: id, ParameterText: name, ValueText: value}
+	}
+	table := NewTable([]digikey.Product{
+		{ManufacturerProductNumber: "A", Parameters: []digikey.Parameter{
+			param(1, "Package", "SOIC-8"),
+			param(2, "Tolerance", "1%"),
+			// Only the first of a repeated parameter is kept.
+			param(2, "Tolerance", "5%"),
+		}},
+		{ManufacturerProductNumber: "B", UnitPrice: digikey.NewMoney(0.25, "USD"), Parameters: []digikey.Parameter{
+			param(2, "Tolerance", "2%"),
+			param(3, "Power", "0.1W"),
+		}},
+		{ManufacturerProductNumber: "C", Parameters: []digikey.Parameter{
+			param(3, "Power", "0.25W"),
+			param(2, "Tolerance", "5%"),
+		}},
+	})
+
+	// Tolerance is on every product, Power on two, and Package on one.
+	if want := append(slices.Clone(productColumns), "Tolerance", "Power", "Package"); !slices.Equal(table.Columns, want) {
+		t.Errorf("columns = %q, want %q", table.Columns, want)
+	}
+	n := len(productColumns)
+	for i, want := range [][]string{
+		{"A", "", "1%", "", "SOIC-8"},
+		{"B", "0.25", "2%", "0.1W", ""},
+		{"C", "", "5%", "0.25W", ""},
+	} {
+		row := table.Rows[i]
+		if got := []string{row[1], row[8], row[n], row[n+1], row[n+2]}; !slices.Equal(got, want) {
+			t.Errorf("row %d = %q, want %q", i, got, want)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// ErrNoDatasheet is returned when a product has no datasheet.
+var ErrNoDatasheet = errors.New("product has no datasheet")
+
+// DatasheetURL returns the datasheet URL of the product with the given
+// DigiKey or manufacturer product number. Protocol-relative URLs are
+// returned with the https scheme.
+func (s *ProductsService) DatasheetURL(ctx context.Context, partNumber string, opts ...RequestOption) (string, error) {
+	p, err := s.ProductDetails(ctx, partNumber, opts...)
+	if err != nil {
+		return "", err
+	}
+	u := strings.TrimSpace(p.DatasheetURL)
+	if u == "" {
+		return "", ErrNoDatasheet
+	}
+	if strings.HasPrefix(u, "//") {
+		u = "https:" + u
+	}
+	return u, nil
+}
+
+// DownloadDatasheet resolves the datasheet URL of the product and streams
+// the datasheet to w, following redirects. Datasheets are usually hosted by
+// the manufacturer, so the request carries no DigiKey credentials and is
+// not rate limited. It returns the number of bytes written.
+func (s *ProductsService) DownloadDatasheet(ctx context.Context, partNumber string, w io.Writer, opts ...RequestOption) (int64, error) {
+	u, err := s.DatasheetURL(ctx, partNumber, opts...)
+	if err != nil {
+		return 0, err
+	}
+	return s.client.Download(ctx, u, w)
+}
+
+// Download streams the file at the URL, such as a datasheet or product
+// photo, to w, following redirects. The request goes through the client's
+// HTTP client, so proxy and TLS settings apply, but it carries no DigiKey
+// credentials and is not rate limited. Protocol-relative URLs are fetched
+// with the https scheme. It returns the number of bytes written.
+func (c *Client) Download(ctx context.Context, u string, w io.Writer) (int64, error) {
+	if c.offline {
+		return 0, ErrOffline
+	}
+	if strings.HasPrefix(u, "//") {
+		u = "https:" + u
+	}
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("User-Agent", c.userAgent)
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("error downloading %s: %w", u, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return 0, Error{StatusCode: resp.StatusCode, Message: "download " + u}
+	}
+	return io.Copy(w, resp.Body)
+}
@@ -0,0 +1,23 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+// Package digikeymock provides mock implementations of the service
+// interfaces of the digikey package, such as digikey.ProductsAPI, so code
+// using them can be unit tested without network access, e.g.
+//
+//	products := &digikeymock.ProductsAPI{
+//		ProductDetailsFunc: func(ctx context.Context, pn string, opts ...digikey.RequestOption) (*digikey.Product, error) {
+//			return &digikey.Product{ManufacturerProductNumber: pn, QuantityAvailable: 10}, nil
+//		},
+//	}
+package digikeymock
+
+//go:generate go run ../internal/genmock -src ../api.go -out mocks.go
+
+// Call is a call made to a mock.
+type Call struct {
+	Method string
+	Args   []any
+}
@@ -0,0 +1,672 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+// Code generated by genmock from api.go. DO NOT EDIT.
+
+package digikeymock
+
+import (
+	"context"
+	"io"
+	"iter"
+	"sync"
+	"time"
+
+	"github.com/apidepot/digikey"
+)
+
+// ProductsAPI is a mock digikey.ProductsAPI.
+// Each method calls the field named after it with the suffix Func,
+// panicking if the field is nil.
+type ProductsAPI struct {
+	KeywordSearchFunc            func(ctx context.Context, req digikey.KeywordRequest, opts ...digikey.RequestOption) (*digikey.KeywordResponse, error)
+	KeywordSearchAllFunc         func(ctx context.Context, req digikey.KeywordRequest, opts ...digikey.RequestOption) iter.Seq2[digikey.Product, error]
+	KeywordSearchStreamFunc      func(ctx context.Context, req digikey.KeywordRequest, fn func(digikey.Product) error, opts ...digikey.RequestOption) (*digikey.KeywordResponse, error)
+	CountFunc                    func(ctx context.Context, req digikey.KeywordRequest, opts ...digikey.RequestOption) (int, error)
+	ResolveFunc                  func(ctx context.Context, req digikey.KeywordRequest, opts ...digikey.RequestOption) (digikey.KeywordRequest, error)
+	SearchManyFunc               func(ctx context.Context, keywords []string, opts ...digikey.RequestOption) map[string]digikey.SearchResult
+	ProductDetailsFunc           func(ctx context.Context, partNumber string, opts ...digikey.RequestOption) (*digikey.Product, error)
+	KeywordSearchV3Func          func(ctx context.Context, req digikey.KeywordSearchRequestV3, opts ...digikey.RequestOption) (*digikey.KeywordSearchResponseV3, error)
+	ProductDetailsV3Func         func(ctx context.Context, partNumber string, opts ...digikey.RequestOption) (*digikey.ProductV3, error)
+	ByManufacturerPartNumberFunc func(ctx context.Context, mpn string, opts ...digikey.RequestOption) ([]digikey.PartMatch, error)
+	ExactMPNFunc                 func(ctx context.Context, mpn string, opts ...digikey.RequestOption) (*digikey.ExactMPNResult, error)
+	PricingFunc                  func(ctx context.Context, partNumber string, opts ...digikey.RequestOption) ([]digikey.ProductPricing, error)
+	MediaFunc                    func(ctx context.Context, partNumber string, opts ...digikey.RequestOption) (*digikey.Media, error)
+	DatasheetURLFunc             func(ctx context.Context, partNumber string, opts ...digikey.RequestOption) (string, error)
+	DownloadDatasheetFunc        func(ctx context.Context, partNumber string, w io.Writer, opts ...digikey.RequestOption) (int64, error)
+	SubstitutionsFunc            func(ctx context.Context, partNumber string, opts ...digikey.RequestOption) ([]digikey.ProductSubstitute, error)
+	AssociationsFunc             func(ctx context.Context, partNumber string, opts ...digikey.RequestOption) (*digikey.ProductAssociations, error)
+	RecommendedProductsFunc      func(ctx context.Context, partNumber string, limit int, opts ...digikey.RequestOption) ([]digikey.RecommendedProduct, error)
+	ChangeNotificationsFunc      func(ctx context.Context, digiKeyPartNumber string, opts ...digikey.RequestOption) ([]digikey.ProductChangeNotification, error)
+	FindAlternatesFunc           func(ctx context.Context, partNumber string, criteria digikey.AlternateCriteria, opts ...digikey.RequestOption) ([]digikey.Alternate, error)
+	CompareFunc                  func(ctx context.Context, partNumbers []string, opts ...digikey.RequestOption) (*digikey.Comparison, error)
+	LifecycleAuditFunc           func(ctx context.Context, partNumbers []string, opts ...digikey.RequestOption) ([]digikey.LifecycleAuditResult, error)
+	CategoriesFunc               func(ctx context.Context, opts ...digikey.RequestOption) ([]digikey.Category, error)
+	CategoriesStreamFunc         func(ctx context.Context, fn func(digikey.Category) error, opts ...digikey.RequestOption) error
+	CategoryByIDFunc             func(ctx context.Context, id int, opts ...digikey.RequestOption) (*digikey.Category, error)
+	ManufacturersFunc            func(ctx context.Context, opts ...digikey.RequestOption) ([]digikey.Manufacturer, error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ digikey.ProductsAPI = (*ProductsAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *ProductsAPI) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+// KeywordSearch implements digikey.ProductsAPI.
+func (m *ProductsAPI) KeywordSearch(ctx context.Context, req digikey.KeywordRequest, opts ...digikey.RequestOption) (*digikey.KeywordResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "KeywordSearch", Args: []any{ctx, req, opts}})
+	m.mu.Unlock()
+	if m.KeywordSearchFunc == nil {
+		panic("digikeymock: ProductsAPI.KeywordSearchFunc is nil")
+	}
+	return m.KeywordSearchFunc(ctx, req, opts...)
+}
+
+// KeywordSearchAll implements digikey.ProductsAPI.
+func (m *ProductsAPI) KeywordSearchAll(ctx context.Context, req digikey.KeywordRequest, opts ...digikey.RequestOption) iter.Seq2[digikey.Product, error] {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "KeywordSearchAll", Args: []any{ctx, req, opts}})
+	m.mu.Unlock()
+	if m.KeywordSearchAllFunc == nil {
+		panic("digikeymock: ProductsAPI.KeywordSearchAllFunc is nil")
+	}
+	return m.KeywordSearchAllFunc(ctx, req, opts...)
+}
+
+// KeywordSearchStream implements digikey.ProductsAPI.
+func (m *ProductsAPI) KeywordSearchStream(ctx context.Context, req digikey.KeywordRequest, fn func(digikey.Product) error, opts ...digikey.RequestOption) (*digikey.KeywordResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "KeywordSearchStream", Args: []any{ctx, req, fn, opts}})
+	m.mu.Unlock()
+	if m.KeywordSearchStreamFunc == nil {
+		panic("digikeymock: ProductsAPI.KeywordSearchStreamFunc is nil")
+	}
+	return m.KeywordSearchStreamFunc(ctx, req, fn, opts...)
+}
+
+// Count implements digikey.ProductsAPI.
+func (m *ProductsAPI) Count(ctx context.Context, req digikey.KeywordRequest, opts ...digikey.RequestOption) (int, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "Count", Args: []any{ctx, req, opts}})
+	m.mu.Unlock()
+	if m.CountFunc == nil {
+		panic("digikeymock: ProductsAPI.CountFunc is nil")
+	}
+	return m.CountFunc(ctx, req, opts...)
+}
+
+// Resolve implements digikey.ProductsAPI.
+func (m *ProductsAPI) Resolve(ctx context.Context, req digikey.KeywordRequest, opts ...digikey.RequestOption) (digikey.KeywordRequest, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "Resolve", Args: []any{ctx, req, opts}})
+	m.mu.Unlock()
+	if m.ResolveFunc == nil {
+		panic("digikeymock: ProductsAPI.ResolveFunc is nil")
+	}
+	return m.ResolveFunc(ctx, req, opts...)
+}
+
+// SearchMany implements digikey.ProductsAPI.
+func (m *ProductsAPI) SearchMany(ctx context.Context, keywords []string, opts ...digikey.RequestOption) map[string]digikey.SearchResult {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "SearchMany", Args: []any{ctx, keywords, opts}})
+	m.mu.Unlock()
+	if m.SearchManyFunc == nil {
+		panic("digikeymock: ProductsAPI.SearchManyFunc is nil")
+	}
+	return m.SearchManyFunc(ctx, keywords, opts...)
+}
+
+// ProductDetails implements digikey.ProductsAPI.
+func (m *ProductsAPI) ProductDetails(ctx context.Context, partNumber string, opts ...digikey.RequestOption) (*digikey.Product, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ProductDetails", Args: []any{ctx, partNumber, opts}})
+	m.mu.Unlock()
+	if m.ProductDetailsFunc == nil {
+		panic("digikeymock: ProductsAPI.ProductDetailsFunc is nil")
+	}
+	return m.ProductDetailsFunc(ctx, partNumber, opts...)
+}
+
+// KeywordSearchV3 implements digikey.ProductsAPI.
+func (m *ProductsAPI) KeywordSearchV3(ctx context.Context, req digikey.KeywordSearchRequestV3, opts ...digikey.RequestOption) (*digikey.KeywordSearchResponseV3, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "KeywordSearchV3", Args: []any{ctx, req, opts}})
+	m.mu.Unlock()
+	if m.KeywordSearchV3Func == nil {
+		panic("digikeymock: ProductsAPI.KeywordSearchV3Func is nil")
+	}
+	return m.KeywordSearchV3Func(ctx, req, opts...)
+}
+
+// ProductDetailsV3 implements digikey.ProductsAPI.
+func (m *ProductsAPI) ProductDetailsV3(ctx context.Context, partNumber string, opts ...digikey.RequestOption) (*digikey.ProductV3, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ProductDetailsV3", Args: []any{ctx, partNumber, opts}})
+	m.mu.Unlock()
+	if m.ProductDetailsV3Func == nil {
+		panic("digikeymock: ProductsAPI.ProductDetailsV3Func is nil")
+	}
+	return m.ProductDetailsV3Func(ctx, partNumber, opts...)
+}
+
+// ByManufacturerPartNumber implements digikey.ProductsAPI.
+func (m *ProductsAPI) ByManufacturerPartNumber(ctx context.Context, mpn string, opts ...digikey.RequestOption) ([]digikey.PartMatch, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ByManufacturerPartNumber", Args: []any{ctx, mpn, opts}})
+	m.mu.Unlock()
+	if m.ByManufacturerPartNumberFunc == nil {
+		panic("digikeymock: ProductsAPI.ByManufacturerPartNumberFunc is nil")
+	}
+	return m.ByManufacturerPartNumberFunc(ctx, mpn, opts...)
+}
+
+// ExactMPN implements digikey.ProductsAPI.
+func (m *ProductsAPI) ExactMPN(ctx context.Context, mpn string, opts ...digikey.RequestOption) (*digikey.ExactMPNResult, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ExactMPN", Args: []any{ctx, mpn, opts}})
+	m.mu.Unlock()
+	if m.ExactMPNFunc == nil {
+		panic("digikeymock: ProductsAPI.ExactMPNFunc is nil")
+	}
+	return m.ExactMPNFunc(ctx, mpn, opts...)
+}
+
+// Pricing implements digikey.ProductsAPI.
+func (m *ProductsAPI) Pricing(ctx context.Context, partNumber string, opts ...digikey.RequestOption) ([]digikey.ProductPricing, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "Pricing", Args: []any{ctx, partNumber, opts}})
+	m.mu.Unlock()
+	if m.PricingFunc == nil {
+		panic("digikeymock: ProductsAPI.PricingFunc is nil")
+	}
+	return m.PricingFunc(ctx, partNumber, opts...)
+}
+
+// Media implements digikey.ProductsAPI.
+func (m *ProductsAPI) Media(ctx context.Context, partNumber string, opts ...digikey.RequestOption) (*digikey.Media, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "Media", Args: []any{ctx, partNumber, opts}})
+	m.mu.Unlock()
+	if m.MediaFunc == nil {
+		panic("digikeymock: ProductsAPI.MediaFunc is nil")
+	}
+	return m.MediaFunc(ctx, partNumber, opts...)
+}
+
+// DatasheetURL implements digikey.ProductsAPI.
+func (m *ProductsAPI) DatasheetURL(ctx context.Context, partNumber string, opts ...digikey.RequestOption) (string, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "DatasheetURL", Args: []any{ctx, partNumber, opts}})
+	m.mu.Unlock()
+	if m.DatasheetURLFunc == nil {
+		panic("digikeymock: ProductsAPI.DatasheetURLFunc is nil")
+	}
+	return m.DatasheetURLFunc(ctx, partNumber, opts...)
+}
+
+// DownloadDatasheet implements digikey.ProductsAPI.
+func (m *ProductsAPI) DownloadDatasheet(ctx context.Context, partNumber string, w io.Writer, opts ...digikey.RequestOption) (int64, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "DownloadDatasheet", Args: []any{ctx, partNumber, w, opts}})
+	m.mu.Unlock()
+	if m.DownloadDatasheetFunc == nil {
+		panic("digikeymock: ProductsAPI.DownloadDatasheetFunc is nil")
+	}
+	return m.DownloadDatasheetFunc(ctx, partNumber, w, opts...)
+}
+
+// Substitutions implements digikey.ProductsAPI.
+func (m *ProductsAPI) Substitutions(ctx context.Context, partNumber string, opts ...digikey.RequestOption) ([]digikey.ProductSubstitute, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "Substitutions", Args: []any{ctx, partNumber, opts}})
+	m.mu.Unlock()
+	if m.SubstitutionsFunc == nil {
+		panic("digikeymock: ProductsAPI.SubstitutionsFunc is nil")
+	}
+	return m.SubstitutionsFunc(ctx, partNumber, opts...)
+}
+
+// Associations implements digikey.ProductsAPI.
+func (m *ProductsAPI) Associations(ctx context.Context, partNumber string, opts ...digikey.RequestOption) (*digikey.ProductAssociations, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "Associations", Args: []any{ctx, partNumber, opts}})
+	m.mu.Unlock()
+	if m.AssociationsFunc == nil {
+		panic("digikeymock: ProductsAPI.AssociationsFunc is nil")
+	}
+	return m.AssociationsFunc(ctx, partNumber, opts...)
+}
+
+// RecommendedProducts implements digikey.ProductsAPI.
+func (m *ProductsAPI) RecommendedProducts(ctx context.Context, partNumber string, limit int, opts ...digikey.RequestOption) ([]digikey.RecommendedProduct, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "RecommendedProducts", Args: []any{ctx, partNumber, limit, opts}})
+	m.mu.Unlock()
+	if m.RecommendedProductsFunc == nil {
+		panic("digikeymock: ProductsAPI.RecommendedProductsFunc is nil")
+	}
+	return m.RecommendedProductsFunc(ctx, partNumber, limit, opts...)
+}
+
+// ChangeNotifications implements digikey.ProductsAPI.
+func (m *ProductsAPI) ChangeNotifications(ctx context.Context, digiKeyPartNumber string, opts ...digikey.RequestOption) ([]digikey.ProductChangeNotification, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ChangeNotifications", Args: []any{ctx, digiKeyPartNumber, opts}})
+	m.mu.Unlock()
+	if m.ChangeNotificationsFunc == nil {
+		panic("digikeymock: ProductsAPI.ChangeNotificationsFunc is nil")
+	}
+	return m.ChangeNotificationsFunc(ctx, digiKeyPartNumber, opts...)
+}
+
+// FindAlternates implements digikey.ProductsAPI.
+func (m *ProductsAPI) FindAlternates(ctx context.Context, partNumber string, criteria digikey.AlternateCriteria, opts ...digikey.RequestOption) ([]digikey.Alternate, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "FindAlternates", Args: []any{ctx, partNumber, criteria, opts}})
+	m.mu.Unlock()
+	if m.FindAlternatesFunc == nil {
+		panic("digikeymock: ProductsAPI.FindAlternatesFunc is nil")
+	}
+	return m.FindAlternatesFunc(ctx, partNumber, criteria, opts...)
+}
+
+// Compare implements digikey.ProductsAPI.
+func (m *ProductsAPI) Compare(ctx context.Context, partNumbers []string, opts ...digikey.RequestOption) (*digikey.Comparison, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "Compare", Args: []any{ctx, partNumbers, opts}})
+	m.mu.Unlock()
+	if m.CompareFunc == nil {
+		panic("digikeymock: ProductsAPI.CompareFunc is nil")
+	}
+	return m.CompareFunc(ctx, partNumbers, opts...)
+}
+
+// LifecycleAudit implements digikey.ProductsAPI.
+func (m *ProductsAPI) LifecycleAudit(ctx context.Context, partNumbers []string, opts ...digikey.RequestOption) ([]digikey.LifecycleAuditResult, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "LifecycleAudit", Args: []any{ctx, partNumbers, opts}})
+	m.mu.Unlock()
+	if m.LifecycleAuditFunc == nil {
+		panic("digikeymock: ProductsAPI.LifecycleAuditFunc is nil")
+	}
+	return m.LifecycleAuditFunc(ctx, partNumbers, opts...)
+}
+
+// Categories implements digikey.ProductsAPI.
+func (m *ProductsAPI) Categories(ctx context.Context, opts ...digikey.RequestOption) ([]digikey.Category, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "Categories", Args: []any{ctx, opts}})
+	m.mu.Unlock()
+	if m.CategoriesFunc == nil {
+		panic("digikeymock: ProductsAPI.CategoriesFunc is nil")
+	}
+	return m.CategoriesFunc(ctx, opts...)
+}
+
+// CategoriesStream implements digikey.ProductsAPI.
+func (m *ProductsAPI) CategoriesStream(ctx context.Context, fn func(digikey.Category) error, opts ...digikey.RequestOption) error {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "CategoriesStream", Args: []any{ctx, fn, opts}})
+	m.mu.Unlock()
+	if m.CategoriesStreamFunc == nil {
+		panic("digikeymock: ProductsAPI.CategoriesStreamFunc is nil")
+	}
+	return m.CategoriesStreamFunc(ctx, fn, opts...)
+}
+
+// CategoryByID implements digikey.ProductsAPI.
+func (m *ProductsAPI) CategoryByID(ctx context.Context, id int, opts ...digikey.RequestOption) (*digikey.Category, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "CategoryByID", Args: []any{ctx, id, opts}})
+	m.mu.Unlock()
+	if m.CategoryByIDFunc == nil {
+		panic("digikeymock: ProductsAPI.CategoryByIDFunc is nil")
+	}
+	return m.CategoryByIDFunc(ctx, id, opts...)
+}
+
+// Manufacturers implements digikey.ProductsAPI.
+func (m *ProductsAPI) Manufacturers(ctx context.Context, opts ...digikey.RequestOption) ([]digikey.Manufacturer, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "Manufacturers", Args: []any{ctx, opts}})
+	m.mu.Unlock()
+	if m.ManufacturersFunc == nil {
+		panic("digikeymock: ProductsAPI.ManufacturersFunc is nil")
+	}
+	return m.ManufacturersFunc(ctx, opts...)
+}
+
+// OrdersAPI is a mock digikey.OrdersAPI.
+// Each method calls the field named after it with the suffix Func,
+// panicking if the field is nil.
+type OrdersAPI struct {
+	StatusFunc          func(ctx context.Context, salesOrderID int, opts ...digikey.RequestOption) (*digikey.SalesOrder, error)
+	DetailsFunc         func(ctx context.Context, salesOrderID int, opts ...digikey.RequestOption) ([]digikey.LineItem, error)
+	HistoryPageFunc     func(ctx context.Context, req digikey.OrderHistoryRequest, opts ...digikey.RequestOption) (*digikey.OrderHistoryPage, error)
+	HistoryFunc         func(ctx context.Context, from, to time.Time, opts ...digikey.RequestOption) iter.Seq2[digikey.SalesOrder, error]
+	OpenBackordersFunc  func(ctx context.Context, since time.Time, opts ...digikey.RequestOption) ([]digikey.Backorder, error)
+	WaitForShipmentFunc func(ctx context.Context, salesOrderID int, pollInterval time.Duration, progress func(digikey.ShipmentProgress), opts ...digikey.RequestOption) (*digikey.SalesOrder, error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ digikey.OrdersAPI = (*OrdersAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *OrdersAPI) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+// Status implements digikey.OrdersAPI.
+func (m *OrdersAPI) Status(ctx context.Context, salesOrderID int, opts ...digikey.RequestOption) (*digikey.SalesOrder, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "Status", Args: []any{ctx, salesOrderID, opts}})
+	m.mu.Unlock()
+	if m.StatusFunc == nil {
+		panic("digikeymock: OrdersAPI.StatusFunc is nil")
+	}
+	return m.StatusFunc(ctx, salesOrderID, opts...)
+}
+
+// Details implements digikey.OrdersAPI.
+func (m *OrdersAPI) Details(ctx context.Context, salesOrderID int, opts ...digikey.RequestOption) ([]digikey.LineItem, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "Details", Args: []any{ctx, salesOrderID, opts}})
+	m.mu.Unlock()
+	if m.DetailsFunc == nil {
+		panic("digikeymock: OrdersAPI.DetailsFunc is nil")
+	}
+	return m.DetailsFunc(ctx, salesOrderID, opts...)
+}
+
+// HistoryPage implements digikey.OrdersAPI.
+func (m *OrdersAPI) HistoryPage(ctx context.Context, req digikey.OrderHistoryRequest, opts ...digikey.RequestOption) (*digikey.OrderHistoryPage, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "HistoryPage", Args: []any{ctx, req, opts}})
+	m.mu.Unlock()
+	if m.HistoryPageFunc == nil {
+		panic("digikeymock: OrdersAPI.HistoryPageFunc is nil")
+	}
+	return m.HistoryPageFunc(ctx, req, opts...)
+}
+
+// History implements digikey.OrdersAPI.
+func (m *OrdersAPI) History(ctx context.Context, from time.Time, to time.Time, opts ...digikey.RequestOption) iter.Seq2[digikey.SalesOrder, error] {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "History", Args: []any{ctx, from, to, opts}})
+	m.mu.Unlock()
+	if m.HistoryFunc == nil {
+		panic("digikeymock: OrdersAPI.HistoryFunc is nil")
+	}
+	return m.HistoryFunc(ctx, from, to, opts...)
+}
+
+// OpenBackorders implements digikey.OrdersAPI.
+func (m *OrdersAPI) OpenBackorders(ctx context.Context, since time.Time, opts ...digikey.RequestOption) ([]digikey.Backorder, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "OpenBackorders", Args: []any{ctx, since, opts}})
+	m.mu.Unlock()
+	if m.OpenBackordersFunc == nil {
+		panic("digikeymock: OrdersAPI.OpenBackordersFunc is nil")
+	}
+	return m.OpenBackordersFunc(ctx, since, opts...)
+}
+
+// WaitForShipment implements digikey.OrdersAPI.
+func (m *OrdersAPI) WaitForShipment(ctx context.Context, salesOrderID int, pollInterval time.Duration, progress func(digikey.ShipmentProgress), opts ...digikey.RequestOption) (*digikey.SalesOrder, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "WaitForShipment", Args: []any{ctx, salesOrderID, pollInterval, progress, opts}})
+	m.mu.Unlock()
+	if m.WaitForShipmentFunc == nil {
+		panic("digikeymock: OrdersAPI.WaitForShipmentFunc is nil")
+	}
+	return m.WaitForShipmentFunc(ctx, salesOrderID, pollInterval, progress, opts...)
+}
+
+// ListsAPI is a mock digikey.ListsAPI.
+// Each method calls the field named after it with the suffix Func,
+// panicking if the field is nil.
+type ListsAPI struct {
+	ListFunc        func(ctx context.Context, opts ...digikey.RequestOption) ([]digikey.List, error)
+	GetFunc         func(ctx context.Context, listID string, opts ...digikey.RequestOption) (*digikey.List, error)
+	CreateFunc      func(ctx context.Context, req digikey.CreateListRequest, opts ...digikey.RequestOption) (string, error)
+	RenameFunc      func(ctx context.Context, listID, name string, opts ...digikey.RequestOption) error
+	DeleteFunc      func(ctx context.Context, listID string, opts ...digikey.RequestOption) error
+	PartsFunc       func(ctx context.Context, listID string, opts ...digikey.RequestOption) ([]digikey.ListPart, error)
+	AddPartsFunc    func(ctx context.Context, listID string, parts []digikey.ListPart, opts ...digikey.RequestOption) error
+	RemovePartsFunc func(ctx context.Context, listID string, partIDs []string, opts ...digikey.RequestOption) error
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ digikey.ListsAPI = (*ListsAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *ListsAPI) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+// List implements digikey.ListsAPI.
+func (m *ListsAPI) List(ctx context.Context, opts ...digikey.RequestOption) ([]digikey.List, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "List", Args: []any{ctx, opts}})
+	m.mu.Unlock()
+	if m.ListFunc == nil {
+		panic("digikeymock: ListsAPI.ListFunc is nil")
+	}
+	return m.ListFunc(ctx, opts...)
+}
+
+// Get implements digikey.ListsAPI.
+func (m *ListsAPI) Get(ctx context.Context, listID string, opts ...digikey.RequestOption) (*digikey.List, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "Get", Args: []any{ctx, listID, opts}})
+	m.mu.Unlock()
+	if m.GetFunc == nil {
+		panic("digikeymock: ListsAPI.GetFunc is nil")
+	}
+	return m.GetFunc(ctx, listID, opts...)
+}
+
+// Create implements digikey.ListsAPI.
+func (m *ListsAPI) Create(ctx context.Context, req digikey.CreateListRequest, opts ...digikey.RequestOption) (string, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "Create", Args: []any{ctx, req, opts}})
+	m.mu.Unlock()
+	if m.CreateFunc == nil {
+		panic("digikeymock: ListsAPI.CreateFunc is nil")
+	}
+	return m.CreateFunc(ctx, req, opts...)
+}
+
+// Rename implements digikey.ListsAPI.
+func (m *ListsAPI) Rename(ctx context.Context, listID string, name string, opts ...digikey.RequestOption) error {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "Rename", Args: []any{ctx, listID, name, opts}})
+	m.mu.Unlock()
+	if m.RenameFunc == nil {
+		panic("digikeymock: ListsAPI.RenameFunc is nil")
+	}
+	return m.RenameFunc(ctx, listID, name, opts...)
+}
+
+// Delete implements digikey.ListsAPI.
+func (m *ListsAPI) Delete(ctx context.Context, listID string, opts ...digikey.RequestOption) error {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "Delete", Args: []any{ctx, listID, opts}})
+	m.mu.Unlock()
+	if m.DeleteFunc == nil {
+		panic("digikeymock: ListsAPI.DeleteFunc is nil")
+	}
+	return m.DeleteFunc(ctx, listID, opts...)
+}
+
+// Parts implements digikey.ListsAPI.
+func (m *ListsAPI) Parts(ctx context.Context, listID string, opts ...digikey.RequestOption) ([]digikey.ListPart, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "Parts", Args: []any{ctx, listID, opts}})
+	m.mu.Unlock()
+	if m.PartsFunc == nil {
+		panic("digikeymock: ListsAPI.PartsFunc is nil")
+	}
+	return m.PartsFunc(ctx, listID, opts...)
+}
+
+// AddParts implements digikey.ListsAPI.
+func (m *ListsAPI) AddParts(ctx context.Context, listID string, parts []digikey.ListPart, opts ...digikey.RequestOption) error {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "AddParts", Args: []any{ctx, listID, parts, opts}})
+	m.mu.Unlock()
+	if m.AddPartsFunc == nil {
+		panic("digikeymock: ListsAPI.AddPartsFunc is nil")
+	}
+	return m.AddPartsFunc(ctx, listID, parts, opts...)
+}
+
+// RemoveParts implements digikey.ListsAPI.
+func (m *ListsAPI) RemoveParts(ctx context.Context, listID string, partIDs []string, opts ...digikey.RequestOption) error {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "RemoveParts", Args: []any{ctx, listID, partIDs, opts}})
+	m.mu.Unlock()
+	if m.RemovePartsFunc == nil {
+		panic("digikeymock: ListsAPI.RemovePartsFunc is nil")
+	}
+	return m.RemovePartsFunc(ctx, listID, partIDs, opts...)
+}
+
+// QuotesAPI is a mock digikey.QuotesAPI.
+// Each method calls the field named after it with the suffix Func,
+// panicking if the field is nil.
+type QuotesAPI struct {
+	CreateFunc func(ctx context.Context, req digikey.QuoteRequest, opts ...digikey.RequestOption) (*digikey.Quote, error)
+	GetFunc    func(ctx context.Context, quoteID int, opts ...digikey.RequestOption) (*digikey.Quote, error)
+	ListFunc   func(ctx context.Context, opts ...digikey.RequestOption) ([]digikey.Quote, error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ digikey.QuotesAPI = (*QuotesAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *QuotesAPI) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+// Create implements digikey.QuotesAPI.
+func (m *QuotesAPI) Create(ctx context.Context, req digikey.QuoteRequest, opts ...digikey.RequestOption) (*digikey.Quote, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "Create", Args: []any{ctx, req, opts}})
+	m.mu.Unlock()
+	if m.CreateFunc == nil {
+		panic("digikeymock: QuotesAPI.CreateFunc is nil")
+	}
+	return m.CreateFunc(ctx, req, opts...)
+}
+
+// Get implements digikey.QuotesAPI.
+func (m *QuotesAPI) Get(ctx context.Context, quoteID int, opts ...digikey.RequestOption) (*digikey.Quote, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "Get", Args: []any{ctx, quoteID, opts}})
+	m.mu.Unlock()
+	if m.GetFunc == nil {
+		panic("digikeymock: QuotesAPI.GetFunc is nil")
+	}
+	return m.GetFunc(ctx, quoteID, opts...)
+}
+
+// List implements digikey.QuotesAPI.
+func (m *QuotesAPI) List(ctx context.Context, opts ...digikey.RequestOption) ([]digikey.Quote, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "List", Args: []any{ctx, opts}})
+	m.mu.Unlock()
+	if m.ListFunc == nil {
+		panic("digikeymock: QuotesAPI.ListFunc is nil")
+	}
+	return m.ListFunc(ctx, opts...)
+}
+
+// BarcodingAPI is a mock digikey.BarcodingAPI.
+// Each method calls the field named after it with the suffix Func,
+// panicking if the field is nil.
+type BarcodingAPI struct {
+	ProductBarcodeFunc    func(ctx context.Context, barcode string, opts ...digikey.RequestOption) (*digikey.ProductBarcode, error)
+	Product2DBarcodeFunc  func(ctx context.Context, barcode string, opts ...digikey.RequestOption) (*digikey.Product2DBarcode, error)
+	PackListBarcodeFunc   func(ctx context.Context, barcode string, opts ...digikey.RequestOption) (*digikey.PackListBarcode, error)
+	PackList2DBarcodeFunc func(ctx context.Context, barcode string, opts ...digikey.RequestOption) (*digikey.PackListBarcode, error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ digikey.BarcodingAPI = (*BarcodingAPI)(nil)
+
+// Calls returns the calls made to the mock, in order.
+func (m *BarcodingAPI) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+// ProductBarcode implements digikey.BarcodingAPI.
+func (m *BarcodingAPI) ProductBarcode(ctx context.Context, barcode string, opts ...digikey.RequestOption) (*digikey.ProductBarcode, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "ProductBarcode", Args: []any{ctx, barcode, opts}})
+	m.mu.Unlock()
+	if m.ProductBarcodeFunc == nil {
+		panic("digikeymock: BarcodingAPI.ProductBarcodeFunc is nil")
+	}
+	return m.ProductBarcodeFunc(ctx, barcode, opts...)
+}
+
+// Product2DBarcode implements digikey.BarcodingAPI.
+func (m *BarcodingAPI) Product2DBarcode(ctx context.Context, barcode string, opts ...digikey.RequestOption) (*digikey.Product2DBarcode, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "Product2DBarcode", Args: []any{ctx, barcode, opts}})
+	m.mu.Unlock()
+	if m.Product2DBarcodeFunc == nil {
+		panic("digikeymock: BarcodingAPI.Product2DBarcodeFunc is nil")
+	}
+	return m.Product2DBarcodeFunc(ctx, barcode, opts...)
+}
+
+// PackListBarcode implements digikey.BarcodingAPI.
+func (m *BarcodingAPI) PackListBarcode(ctx context.Context, barcode string, opts ...digikey.RequestOption) (*digikey.PackListBarcode, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "PackListBarcode", Args: []any{ctx, barcode, opts}})
+	m.mu.Unlock()
+	if m.PackListBarcodeFunc == nil {
+		panic("digikeymock: BarcodingAPI.PackListBarcodeFunc is nil")
+	}
+	return m.PackListBarcodeFunc(ctx, barcode, opts...)
+}
+
+// PackList2DBarcode implements digikey.BarcodingAPI.
+func (m *BarcodingAPI) PackList2DBarcode(ctx context.Context, barcode string, opts ...digikey.RequestOption) (*digikey.PackListBarcode, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: "PackList2DBarcode", Args: []any{ctx, barcode, opts}})
+	m.mu.Unlock()
+	if m.PackList2DBarcodeFunc == nil {
+		panic("digikeymock: BarcodingAPI.PackList2DBarcodeFunc is nil")
+	}
+	return m.PackList2DBarcodeFunc(ctx, barcode, opts...)
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikeypb
+
+import (
+	"fmt"
+
+	"github.com/apidepot/digikey"
+	"google.golang.org/protobuf/proto"
+)
+
+// Codec is a digikey.Codec for protobuf messages. It also serializes
+// *digikey.Snapshot, as a Snapshot of parts, and *digikey.CacheEntry, so it
+// can be passed to digikey.WriteSnapshot and to persistent caches. Product
+// fields that the Part message does not represent are not kept.
+var Codec digikey.Codec = protoCodec{}
+
+type protoCodec struct{}
+
+func (protoCodec) Name() string { return "protobuf" }
+
+func (protoCodec) Marshal(v any) ([]byte, error) {
+	switch v := v.(type) {
+	case proto.Message:
+		return proto.Marshal(v)
+	case *digikey.Snapshot:
+		return proto.Marshal(FromSnapshot(v))
+	case *digikey.CacheEntry:
+		return proto.Marshal(&CacheEntry{
+			Body:      v.Body,
+			StoredAt:  fromTime(digikey.Time{Time: v.StoredAt}),
+			ExpiresAt: fromTime(digikey.Time{Time: v.ExpiresAt}),
+		})
+	}
+	return nil, fmt.Errorf("protobuf codec cannot marshal %T", v)
+}
+
+func (protoCodec) Unmarshal(data []byte, v any) error {
+	switch v := v.(type) {
+	case proto.Message:
+		return proto.Unmarshal(data, v)
+	case *digikey.Snapshot:
+		m := &Snapshot{}
+		if err := proto.Unmarshal(data, m); err != nil {
+			return err
+		}
+		*v = m.ToSnapshot()
+		return nil
+	case *digikey.CacheEntry:
+		m := &CacheEntry{}
+		if err := proto.Unmarshal(data, m); err != nil {
+			return err
+		}
+		*v = digikey.CacheEntry{
+			Body:      m.GetBody(),
+			StoredAt:  toTime(m.GetStoredAt()).Time,
+			ExpiresAt: toTime(m.GetExpiresAt()).Time,
+		}
+		return nil
+	}
+	return fmt.Errorf("protobuf codec cannot unmarshal into %T", v)
+}
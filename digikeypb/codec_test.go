@@ -0,0 +1,65 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikeypb_test
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/apidepot/digikey"
+	"github.com/apidepot/digikey/digikeypb"
+	"github.com/apidepot/digikey/digikeytest"
+)
+
+func TestCodecSnapshot(t *testing.T) {
+	snap := &digikey.Snapshot{TakenAt: time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)}
+	for range 100 {
+		snap.Products = append(snap.Products, digikeytest.DefaultCatalog()...)
+	}
+	var pb, js bytes.Buffer
+	if err := digikey.WriteSnapshot(&pb, digikeypb.Codec, snap); err != nil {
+		t.Fatal(err)
+	}
+	if err := digikey.WriteSnapshot(&js, digikey.JSONCodec, snap); err != nil {
+		t.Fatal(err)
+	}
+	if pb.Len()*2 > js.Len() {
+		t.Errorf("protobuf snapshot is %d bytes, more than half the %d bytes of JSON", pb.Len(), js.Len())
+	}
+	got, err := digikey.ReadSnapshot(&pb, digikeypb.Codec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.TakenAt.Equal(snap.TakenAt) || len(got.Products) != len(snap.Products) {
+		t.Fatalf("ReadSnapshot() = %v with %d products, want %v with %d",
+			got.TakenAt, len(got.Products), snap.TakenAt, len(snap.Products))
+	}
+	want := digikeypb.FromProduct(snap.Products[0]).ToProduct()
+	if !reflect.DeepEqual(got.Products[0], want) {
+		t.Errorf("first product = %+v, want %+v", got.Products[0], want)
+	}
+}
+
+func TestCodecCacheEntry(t *testing.T) {
+	stored := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	want := digikey.CacheEntry{Body: []byte(`{"Product":{}}`), StoredAt: stored, ExpiresAt: stored.Add(time.Hour)}
+	data, err := digikeypb.Codec.Marshal(&want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got digikey.CacheEntry
+	if err := digikeypb.Codec.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Unmarshal() = %+v, want %+v", got, want)
+	}
+	if _, err := digikeypb.Codec.Marshal(digikey.Product{}); err == nil {
+		t.Error("Marshal() of a product succeeded")
+	}
+}
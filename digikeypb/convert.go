@@ -0,0 +1,390 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikeypb
+
+import (
+	"github.com/apidepot/digikey"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// FromProduct converts a product to its protobuf representation.
+func FromProduct(p digikey.Product) *Part {
+	part := &Part{
+		ManufacturerProductNumber: p.ManufacturerProductNumber,
+		Manufacturer: &Manufacturer{
+			Id:   int32(p.Manufacturer.ID),
+			Name: p.Manufacturer.Name,
+		},
+		Description:         p.Description.ProductDescription,
+		DetailedDescription: p.Description.DetailedDescription,
+		ProductUrl:          p.ProductURL,
+		DatasheetUrl:        p.DatasheetURL,
+		PhotoUrl:            p.PhotoURL,
+		Status:              p.ProductStatus.Status,
+		Category: &Category{
+			Id:   int32(p.Category.CategoryID),
+			Name: p.Category.Name,
+		},
+		Availability: FromAvailability(p),
+	}
+	for _, param := range p.Parameters {
+		part.Parameters = append(part.Parameters, &Parameter{
+			Id:    int32(param.ParameterID),
+			Name:  param.ParameterText,
+			Value: param.ValueText,
+		})
+	}
+	for _, v := range p.ProductVariations {
+		part.Variations = append(part.Variations, &Variation{
+			DigikeyProductNumber: v.DigiKeyProductNumber,
+			PackageType:          v.PackageType.Name,
+			PackageTypeId:        int32(v.PackageType.ID),
+			Pricing:              FromPriceBreaks(v.StandardPricing),
+			QuantityAvailable:    int64(v.QuantityAvailableForPackageType),
+			MinimumOrderQuantity: int64(v.MinimumOrderQuantity),
+			StandardPackage:      int64(v.StandardPackage),
+		})
+	}
+	return part
+}
+
+// FromAvailability converts the stock and lifecycle fields of a product to
+// their protobuf representation.
+func FromAvailability(p digikey.Product) *Availability {
+	return &Availability{
+		QuantityAvailable:          int64(p.QuantityAvailable),
+		NormallyStocking:           p.NormallyStocking,
+		Discontinued:               p.Discontinued,
+		EndOfLife:                  p.EndOfLife,
+		ManufacturerLeadWeeks:      p.ManufacturerLeadWeeks,
+		ManufacturerPublicQuantity: int64(p.ManufacturerPublicQuantity),
+	}
+}
+
+// FromPriceBreaks converts price breaks to their protobuf representation,
+// taking the currency from the first break.
+func FromPriceBreaks(breaks []digikey.PriceBreak) *Pricing {
+	pricing := &Pricing{}
+	for _, b := range breaks {
+		if pricing.Currency == "" {
+			pricing.Currency = b.UnitPrice.Currency
+		}
+		pricing.PriceBreaks = append(pricing.PriceBreaks, &PriceBreak{
+			BreakQuantity: int64(b.BreakQuantity),
+			UnitPrice:     b.UnitPrice.Amount,
+			TotalPrice:    b.TotalPrice.Amount,
+		})
+	}
+	return pricing
+}
+
+// ToProduct converts a part back to a product. Fields that have no protobuf
+// representation are left as their zero values.
+func (x *Part) ToProduct() digikey.Product {
+	p := digikey.Product{
+		ManufacturerProductNumber: x.GetManufacturerProductNumber(),
+		Manufacturer: digikey.Manufacturer{
+			ID:   int(x.GetManufacturer().GetId()),
+			Name: x.GetManufacturer().GetName(),
+		},
+		Description: digikey.Description{
+			ProductDescription:  x.GetDescription(),
+			DetailedDescription: x.GetDetailedDescription(),
+		},
+		ProductURL:    x.GetProductUrl(),
+		DatasheetURL:  x.GetDatasheetUrl(),
+		PhotoURL:      x.GetPhotoUrl(),
+		ProductStatus: digikey.ProductStatus{Status: x.GetStatus()},
+		Category: digikey.Category{
+			CategoryID: int(x.GetCategory().GetId()),
+			Name:       x.GetCategory().GetName(),
+		},
+	}
+	if a := x.GetAvailability(); a != nil {
+		p.QuantityAvailable = int(a.GetQuantityAvailable())
+		p.NormallyStocking = a.GetNormallyStocking()
+		p.Discontinued = a.GetDiscontinued()
+		p.EndOfLife = a.GetEndOfLife()
+		p.ManufacturerLeadWeeks = a.GetManufacturerLeadWeeks()
+		p.ManufacturerPublicQuantity = int(a.GetManufacturerPublicQuantity())
+	}
+	for _, param := range x.GetParameters() {
+		p.Parameters = append(p.Parameters, digikey.Parameter{
+			ParameterID:   int(param.GetId()),
+			ParameterText: param.GetName(),
+			ValueText:     param.GetValue(),
+		})
+	}
+	for _, v := range x.GetVariations() {
+		p.ProductVariations = append(p.ProductVariations, digikey.ProductVariation{
+			DigiKeyProductNumber:            v.GetDigikeyProductNumber(),
+			PackageType:                     digikey.IDName{ID: int(v.GetPackageTypeId()), Name: v.GetPackageType()},
+			StandardPricing:                 v.GetPricing().ToPriceBreaks(),
+			QuantityAvailableForPackageType: int(v.GetQuantityAvailable()),
+			MinimumOrderQuantity:            int(v.GetMinimumOrderQuantity()),
+			StandardPackage:                 int(v.GetStandardPackage()),
+		})
+	}
+	return p
+}
+
+// ToPriceBreaks converts the pricing back to price breaks in its currency.
+func (x *Pricing) ToPriceBreaks() []digikey.PriceBreak {
+	var breaks []digikey.PriceBreak
+	for _, b := range x.GetPriceBreaks() {
+		breaks = append(breaks, digikey.PriceBreak{
+			BreakQuantity: int(b.GetBreakQuantity()),
+			UnitPrice:     digikey.NewMoney(b.GetUnitPrice(), x.GetCurrency()),
+			TotalPrice:    digikey.NewMoney(b.GetTotalPrice(), x.GetCurrency()),
+		})
+	}
+	return breaks
+}
+
+// FromSalesOrder converts a sales order to its protobuf representation.
+// Prices are converted to amounts in the order's currency.
+func FromSalesOrder(o digikey.SalesOrder) *Order {
+	a := o.ShippingAddress
+	order := &Order{
+		CustomerId:   int32(o.CustomerID),
+		SalesOrderId: int32(o.SalesOrderID),
+		Status: &OrderStatus{
+			SalesOrderStatus: o.Status.SalesOrderStatus,
+			ShortDescription: o.Status.ShortDescription,
+			LongDescription:  o.Status.LongDescription,
+		},
+		PurchaseOrder: o.PurchaseOrder,
+		TotalPrice:    o.TotalPrice.Amount,
+		DateEntered:   fromTime(o.DateEntered),
+		OrderNumber:   int32(o.OrderNumber),
+		ShipMethod:    o.ShipMethod,
+		Currency:      o.Currency,
+		ShippingAddress: &Address{
+			Company:      a.Company,
+			FirstName:    a.FirstName,
+			LastName:     a.LastName,
+			AddressLine1: a.AddressLine1,
+			AddressLine2: a.AddressLine2,
+			AddressLine3: a.AddressLine3,
+			City:         a.City,
+			State:        a.State,
+			County:       a.County,
+			ZipCode:      a.ZipCode,
+			Country:      a.Country,
+		},
+	}
+	for _, l := range o.LineItems {
+		order.LineItems = append(order.LineItems, FromLineItem(l))
+	}
+	for _, s := range o.Shipments {
+		order.Shipments = append(order.Shipments, FromShipment(s))
+	}
+	return order
+}
+
+// FromLineItem converts a line of a sales order to its protobuf
+// representation.
+func FromLineItem(l digikey.LineItem) *LineItem {
+	item := &LineItem{
+		SalesOrderId:              int32(l.SalesOrderID),
+		DetailId:                  int32(l.DetailID),
+		TotalPrice:                l.TotalPrice.Amount,
+		PurchaseOrder:             l.PurchaseOrder,
+		CustomerReference:         l.CustomerReference,
+		CountryOfOrigin:           l.CountryOfOrigin,
+		DigikeyProductNumber:      l.DigiKeyProductNumber,
+		ManufacturerProductNumber: l.ManufacturerProductNumber,
+		Description:               l.Description,
+		PackType:                  l.PackType,
+		QuantityInitialRequested:  int64(l.QuantityInitialRequested),
+		QuantityOrdered:           int64(l.QuantityOrdered),
+		QuantityShipped:           int64(l.QuantityShipped),
+		QuantityReserved:          int64(l.QuantityReserved),
+		QuantityBackOrder:         int64(l.QuantityBackOrder),
+		UnitPrice:                 l.UnitPrice.Amount,
+		PoLineItemNumber:          l.PoLineItemNumber,
+	}
+	for _, s := range l.ItemShipments {
+		item.ItemShipments = append(item.ItemShipments, &ItemShipment{
+			QuantityShipped:      int64(s.QuantityShipped),
+			InvoiceId:            int32(s.InvoiceID),
+			ShippedDate:          fromTime(s.ShippedDate),
+			TrackingNumber:       s.TrackingNumber,
+			ExpectedDeliveryDate: fromTime(s.ExpectedDeliveryDate),
+		})
+	}
+	for _, s := range l.Schedules {
+		item.Schedules = append(item.Schedules, &Schedule{
+			QuantityScheduled: int64(s.QuantityScheduled),
+			ScheduledDate:     fromTime(s.ScheduledDate),
+		})
+	}
+	return item
+}
+
+// FromShipment converts a shipment to its protobuf representation.
+func FromShipment(s digikey.Shipment) *Shipment {
+	shipment := &Shipment{
+		Carrier:        s.Carrier,
+		ShipMethod:     s.ShipMethod,
+		TrackingNumber: s.TrackingNumber,
+		TrackingUrl:    s.TrackingURL,
+		InvoiceId:      int32(s.InvoiceID),
+		ShippedDate:    fromTime(s.ShippedDate),
+	}
+	for _, b := range s.Boxes {
+		box := &Box{BoxId: b.BoxID, TrackingNumber: b.TrackingNumber}
+		for _, c := range b.Contents {
+			box.Contents = append(box.Contents, &BoxContent{
+				DigikeyProductNumber:      c.DigiKeyProductNumber,
+				ManufacturerProductNumber: c.ManufacturerProductNumber,
+				Quantity:                  int64(c.Quantity),
+			})
+		}
+		shipment.Boxes = append(shipment.Boxes, box)
+	}
+	return shipment
+}
+
+// ToSalesOrder converts an order back to a sales order, with prices in the
+// order's currency.
+func (x *Order) ToSalesOrder() digikey.SalesOrder {
+	a := x.GetShippingAddress()
+	o := digikey.SalesOrder{
+		CustomerID:   int(x.GetCustomerId()),
+		SalesOrderID: int(x.GetSalesOrderId()),
+		Status: digikey.OrderStatus{
+			SalesOrderStatus: x.GetStatus().GetSalesOrderStatus(),
+			ShortDescription: x.GetStatus().GetShortDescription(),
+			LongDescription:  x.GetStatus().GetLongDescription(),
+		},
+		PurchaseOrder: x.GetPurchaseOrder(),
+		TotalPrice:    digikey.NewMoney(x.GetTotalPrice(), x.GetCurrency()),
+		DateEntered:   toTime(x.GetDateEntered()),
+		OrderNumber:   int(x.GetOrderNumber()),
+		ShipMethod:    x.GetShipMethod(),
+		Currency:      x.GetCurrency(),
+		ShippingAddress: digikey.Address{
+			Company:      a.GetCompany(),
+			FirstName:    a.GetFirstName(),
+			LastName:     a.GetLastName(),
+			AddressLine1: a.GetAddressLine1(),
+			AddressLine2: a.GetAddressLine2(),
+			AddressLine3: a.GetAddressLine3(),
+			City:         a.GetCity(),
+			State:        a.GetState(),
+			County:       a.GetCounty(),
+			ZipCode:      a.GetZipCode(),
+			Country:      a.GetCountry(),
+		},
+	}
+	for _, l := range x.GetLineItems() {
+		o.LineItems = append(o.LineItems, l.ToLineItem(x.GetCurrency()))
+	}
+	for _, s := range x.GetShipments() {
+		o.Shipments = append(o.Shipments, s.ToShipment())
+	}
+	return o
+}
+
+// ToLineItem converts the line back to a line item with prices in the given
+// currency.
+func (x *LineItem) ToLineItem(currency string) digikey.LineItem {
+	l := digikey.LineItem{
+		SalesOrderID:              int(x.GetSalesOrderId()),
+		DetailID:                  int(x.GetDetailId()),
+		TotalPrice:                digikey.NewMoney(x.GetTotalPrice(), currency),
+		PurchaseOrder:             x.GetPurchaseOrder(),
+		CustomerReference:         x.GetCustomerReference(),
+		CountryOfOrigin:           x.GetCountryOfOrigin(),
+		DigiKeyProductNumber:      x.GetDigikeyProductNumber(),
+		ManufacturerProductNumber: x.GetManufacturerProductNumber(),
+		Description:               x.GetDescription(),
+		PackType:                  x.GetPackType(),
+		QuantityInitialRequested:  int(x.GetQuantityInitialRequested()),
+		QuantityOrdered:           int(x.GetQuantityOrdered()),
+		QuantityShipped:           int(x.GetQuantityShipped()),
+		QuantityReserved:          int(x.GetQuantityReserved()),
+		QuantityBackOrder:         int(x.GetQuantityBackOrder()),
+		UnitPrice:                 digikey.NewMoney(x.GetUnitPrice(), currency),
+		PoLineItemNumber:          x.GetPoLineItemNumber(),
+	}
+	for _, s := range x.GetItemShipments() {
+		l.ItemShipments = append(l.ItemShipments, digikey.ItemShipment{
+			QuantityShipped:      int(s.GetQuantityShipped()),
+			InvoiceID:            int(s.GetInvoiceId()),
+			ShippedDate:          toTime(s.GetShippedDate()),
+			TrackingNumber:       s.GetTrackingNumber(),
+			ExpectedDeliveryDate: toTime(s.GetExpectedDeliveryDate()),
+		})
+	}
+	for _, s := range x.GetSchedules() {
+		l.Schedules = append(l.Schedules, digikey.Schedule{
+			QuantityScheduled: int(s.GetQuantityScheduled()),
+			ScheduledDate:     toTime(s.GetScheduledDate()),
+		})
+	}
+	return l
+}
+
+// ToShipment converts the shipment back to a digikey.Shipment.
+func (x *Shipment) ToShipment() digikey.Shipment {
+	s := digikey.Shipment{
+		Carrier:        x.GetCarrier(),
+		ShipMethod:     x.GetShipMethod(),
+		TrackingNumber: x.GetTrackingNumber(),
+		TrackingURL:    x.GetTrackingUrl(),
+		InvoiceID:      int(x.GetInvoiceId()),
+		ShippedDate:    toTime(x.GetShippedDate()),
+	}
+	for _, b := range x.GetBoxes() {
+		box := digikey.Box{BoxID: b.GetBoxId(), TrackingNumber: b.GetTrackingNumber()}
+		for _, c := range b.GetContents() {
+			box.Contents = append(box.Contents, digikey.BoxContent{
+				DigiKeyProductNumber:      c.GetDigikeyProductNumber(),
+				ManufacturerProductNumber: c.GetManufacturerProductNumber(),
+				Quantity:                  int(c.GetQuantity()),
+			})
+		}
+		s.Boxes = append(s.Boxes, box)
+	}
+	return s
+}
+
+// fromTime converts a time to a timestamp, or nil if it is zero.
+func fromTime(t digikey.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t.Time)
+}
+
+// toTime converts a timestamp to a time, which is zero if the timestamp is
+// nil.
+func toTime(ts *timestamppb.Timestamp) digikey.Time {
+	if ts == nil {
+		return digikey.Time{}
+	}
+	return digikey.Time{Time: ts.AsTime()}
+}
+
+// FromSnapshot converts a snapshot to its protobuf representation.
+func FromSnapshot(s *digikey.Snapshot) *Snapshot {
+	snap := &Snapshot{TakenAt: fromTime(digikey.Time{Time: s.TakenAt})}
+	for _, p := range s.Products {
+		snap.Parts = append(snap.Parts, FromProduct(p))
+	}
+	return snap
+}
+
+// ToSnapshot converts the snapshot back to a digikey.Snapshot.
+func (x *Snapshot) ToSnapshot() digikey.Snapshot {
+	s := digikey.Snapshot{TakenAt: toTime(x.GetTakenAt()).Time}
+	for _, p := range x.GetParts() {
+		s.Products = append(s.Products, p.ToProduct())
+	}
+	return s
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikeypb_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/apidepot/digikey"
+	"github.com/apidepot/digikey/digikeypb"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestProductRoundTrip(t *testing.T) {
+	want := digikey.Product{
+		ManufacturerProductNumber: "LM358DR",
+		Manufacturer:              digikey.Manufacturer{ID: 296, Name: "Texas Instruments"},
+		Description:               digikey.Description{ProductDescription: "IC OPAMP GP 2 CIRCUIT 8SOIC"},
+		ProductStatus:             digikey.ProductStatus{Status: "Active"},
+		Category:                  digikey.Category{CategoryID: 687, Name: "Instrumentation, Op Amps, Buffer Amps"},
+		QuantityAvailable:         1200,
+		Parameters: []digikey.Parameter{
+			{ParameterID: 2094, ParameterText: "Number of Circuits", ValueText: "2"},
+		},
+		ProductVariations: []digikey.ProductVariation{{
+			DigiKeyProductNumber: "296-1014-1-ND",
+			PackageType:          digikey.IDName{ID: 2, Name: "Cut Tape (CT)"},
+			StandardPricing: []digikey.PriceBreak{
+				{BreakQuantity: 1, UnitPrice: digikey.NewMoney(0.52, "USD"), TotalPrice: digikey.NewMoney(0.52, "USD")},
+				{BreakQuantity: 10, UnitPrice: digikey.NewMoney(0.41, "USD"), TotalPrice: digikey.NewMoney(4.1, "USD")},
+			},
+			QuantityAvailableForPackageType: 1200,
+			MinimumOrderQuantity:            1,
+		}},
+	}
+	got := roundTrip(t, digikeypb.FromProduct(want), &digikeypb.Part{}).ToProduct()
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("round trip of product\ngot  %+v\nwant %+v", got, want)
+	}
+}
+
+func TestSalesOrderRoundTrip(t *testing.T) {
+	shipped := digikey.Time{Time: time.Date(2025, 3, 4, 15, 0, 0, 0, time.UTC)}
+	want := digikey.SalesOrder{
+		CustomerID:      12345,
+		SalesOrderID:    67890,
+		Status:          digikey.OrderStatus{SalesOrderStatus: "Shipped", ShortDescription: "Shipped"},
+		PurchaseOrder:   "PO-1",
+		TotalPrice:      digikey.NewMoney(41, "EUR"),
+		DateEntered:     digikey.Time{Time: time.Date(2025, 3, 1, 9, 30, 0, 0, time.UTC)},
+		ShipMethod:      "UPS",
+		Currency:        "EUR",
+		ShippingAddress: digikey.Address{Company: "Acme", City: "Thief River Falls", Country: "US"},
+		LineItems: []digikey.LineItem{{
+			SalesOrderID:         67890,
+			DetailID:             1,
+			TotalPrice:           digikey.NewMoney(41, "EUR"),
+			DigiKeyProductNumber: "296-1014-1-ND",
+			QuantityOrdered:      100,
+			QuantityShipped:      100,
+			UnitPrice:            digikey.NewMoney(0.41, "EUR"),
+			ItemShipments: []digikey.ItemShipment{
+				{QuantityShipped: 100, InvoiceID: 5, ShippedDate: shipped, TrackingNumber: "1Z999"},
+			},
+		}},
+		Shipments: []digikey.Shipment{{
+			Carrier:        "UPS",
+			TrackingNumber: "1Z999",
+			InvoiceID:      5,
+			ShippedDate:    shipped,
+			Boxes: []digikey.Box{{
+				BoxID:    "B1",
+				Contents: []digikey.BoxContent{{DigiKeyProductNumber: "296-1014-1-ND", Quantity: 100}},
+			}},
+		}},
+	}
+	got := roundTrip(t, digikeypb.FromSalesOrder(want), &digikeypb.Order{}).ToSalesOrder()
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("round trip of sales order\ngot  %+v\nwant %+v", got, want)
+	}
+}
+
+// roundTrip marshals m and unmarshals it into into.
+func roundTrip[M proto.Message](t *testing.T, m, into M) M {
+	t.Helper()
+	data, err := digikeypb.Codec.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := digikeypb.Codec.Unmarshal(data, into); err != nil {
+		t.Fatal(err)
+	}
+	return into
+}
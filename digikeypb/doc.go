@@ -0,0 +1,11 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+// Package digikeypb contains the Go types generated from the protobuf
+// definitions in proto/digikey/v1, and converters to and from the models of
+// the digikey package, so services in other languages can share one schema.
+package digikeypb
+
+//go:generate protoc --proto_path=../proto --go_out=.. --go_opt=module=github.com/apidepot/digikey digikey/v1/models.proto
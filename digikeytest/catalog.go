@@ -0,0 +1,132 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikeytest
+
+import "github.com/apidepot/digikey"
+
+var (
+	opAmpCategory     = digikey.Category{CategoryID: 687, ParentID: 32, Name: "Instrumentation, OP Amps, Buffer Amps"}
+	resistorCategory  = digikey.Category{CategoryID: 52, ParentID: 2, Name: "Chip Resistor - Surface Mount"}
+	capacitorCategory = digikey.Category{CategoryID: 60, ParentID: 3, Name: "Ceramic Capacitors"}
+
+	ti      = digikey.Manufacturer{ID: 296, Name: "Texas Instruments"}
+	yageo   = digikey.Manufacturer{ID: 311, Name: "YAGEO"}
+	samsung = digikey.Manufacturer{ID: 1276, Name: "Samsung Electro-Mechanics"}
+
+	active   = digikey.ProductStatus{ID: 0, Status: "Active"}
+	obsolete = digikey.ProductStatus{ID: 4, Status: "Obsolete"}
+)
+
+// DefaultCatalog returns a small catalog of products covering common test
+// cases: an in-stock part with several packaging options, a part that is
+// out of stock with a factory lead time, and an obsolete part. Each call
+// returns a fresh copy, which the caller may modify.
+func DefaultCatalog() []digikey.Product {
+	return []digikey.Product{
+		{
+			Description:               digikey.Description{ProductDescription: "IC OPAMP GP 2 CIRCUIT 8SOIC"},
+			Manufacturer:              ti,
+			ManufacturerProductNumber: "LM358DR",
+			UnitPrice:                 price(0.42),
+			ProductURL:                "https://www.digikey.com/en/products/detail/texas-instruments/LM358DR/555721",
+			DatasheetURL:              "https://www.ti.com/lit/ds/symlink/lm358.pdf",
+			QuantityAvailable:         152311,
+			ProductStatus:             active,
+			NormallyStocking:          true,
+			ManufacturerLeadWeeks:     "6 Weeks",
+			Category:                  opAmpCategory,
+			Classifications:           digikey.Classifications{RohsStatus: "ROHS3 Compliant", ReachStatus: "REACH Unaffected"},
+			Parameters: []digikey.Parameter{
+				{ParameterID: 2094, ParameterText: "Number of Circuits", ValueID: "2", ValueText: "2"},
+				{ParameterID: 2067, ParameterText: "Gain Bandwidth Product", ValueID: "1MHz", ValueText: "1 MHz"},
+				{ParameterID: 16, ParameterText: "Package / Case", ValueID: "8SOIC", ValueText: "8-SOIC (0.154\", 3.90mm Width)"},
+			},
+			ProductVariations: []digikey.ProductVariation{
+				{
+					DigiKeyProductNumber:            "296-1395-1-ND",
+					PackageType:                     digikey.IDName{ID: 2, Name: "Cut Tape (CT)"},
+					QuantityAvailableForPackageType: 52311,
+					MinimumOrderQuantity:            1,
+					StandardPricing: []digikey.PriceBreak{
+						{BreakQuantity: 1, UnitPrice: price(0.42), TotalPrice: price(0.42)},
+						{BreakQuantity: 10, UnitPrice: price(0.293), TotalPrice: price(2.93)},
+						{BreakQuantity: 100, UnitPrice: price(0.1884), TotalPrice: price(18.84)},
+						{BreakQuantity: 500, UnitPrice: price(0.15298), TotalPrice: price(76.49)},
+					},
+				},
+				{
+					DigiKeyProductNumber:            "296-1395-2-ND",
+					PackageType:                     digikey.IDName{ID: 1, Name: "Tape & Reel (TR)"},
+					QuantityAvailableForPackageType: 100000,
+					MinimumOrderQuantity:            2500,
+					StandardPackage:                 2500,
+					StandardPricing: []digikey.PriceBreak{
+						{BreakQuantity: 2500, UnitPrice: price(0.10886), TotalPrice: price(272.15)},
+					},
+				},
+			},
+		},
+		{
+			Description:               digikey.Description{ProductDescription: "RES 10K OHM 1% 1/10W 0603"},
+			Manufacturer:              yageo,
+			ManufacturerProductNumber: "RC0603FR-0710KL",
+			UnitPrice:                 price(0.10),
+			ProductURL:                "https://www.digikey.com/en/products/detail/yageo/RC0603FR-0710KL/726880",
+			QuantityAvailable:         0,
+			ProductStatus:             active,
+			NormallyStocking:          true,
+			ManufacturerLeadWeeks:     "16 Weeks",
+			Category:                  resistorCategory,
+			Classifications:           digikey.Classifications{RohsStatus: "ROHS3 Compliant"},
+			Parameters: []digikey.Parameter{
+				{ParameterID: 2085, ParameterText: "Resistance", ValueID: "10k", ValueText: "10 kOhms"},
+				{ParameterID: 3, ParameterText: "Tolerance", ValueID: "1%", ValueText: "±1%"},
+				{ParameterID: 16, ParameterText: "Package / Case", ValueID: "0603", ValueText: "0603 (1608 Metric)"},
+			},
+			ProductVariations: []digikey.ProductVariation{
+				{
+					DigiKeyProductNumber: "311-10.0KHRCT-ND",
+					PackageType:          digikey.IDName{ID: 2, Name: "Cut Tape (CT)"},
+					MinimumOrderQuantity: 1,
+					StandardPricing: []digikey.PriceBreak{
+						{BreakQuantity: 1, UnitPrice: price(0.10), TotalPrice: price(0.10)},
+						{BreakQuantity: 10, UnitPrice: price(0.061), TotalPrice: price(0.61)},
+						{BreakQuantity: 100, UnitPrice: price(0.0293), TotalPrice: price(2.93)},
+					},
+				},
+			},
+		},
+		{
+			Description:               digikey.Description{ProductDescription: "CAP CER 0.1UF 50V X7R 0603"},
+			Manufacturer:              samsung,
+			ManufacturerProductNumber: "CL10B104KB8NNNC",
+			UnitPrice:                 price(0.10),
+			ProductURL:                "https://www.digikey.com/en/products/detail/samsung-electro-mechanics/CL10B104KB8NNNC/3886659",
+			QuantityAvailable:         1200,
+			ProductStatus:             obsolete,
+			EndOfLife:                 true,
+			Category:                  capacitorCategory,
+			Classifications:           digikey.Classifications{RohsStatus: "ROHS3 Compliant"},
+			Parameters: []digikey.Parameter{
+				{ParameterID: 2049, ParameterText: "Capacitance", ValueID: "0.1uF", ValueText: "0.1 µF"},
+				{ParameterID: 14, ParameterText: "Voltage - Rated", ValueID: "50V", ValueText: "50V"},
+				{ParameterID: 16, ParameterText: "Package / Case", ValueID: "0603", ValueText: "0603 (1608 Metric)"},
+			},
+			ProductVariations: []digikey.ProductVariation{
+				{
+					DigiKeyProductNumber:            "1276-1000-1-ND",
+					PackageType:                     digikey.IDName{ID: 2, Name: "Cut Tape (CT)"},
+					QuantityAvailableForPackageType: 1200,
+					MinimumOrderQuantity:            1,
+					StandardPricing: []digikey.PriceBreak{
+						{BreakQuantity: 1, UnitPrice: price(0.10), TotalPrice: price(0.10)},
+						{BreakQuantity: 10, UnitPrice: price(0.034), TotalPrice: price(0.34)},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikeytest
+
+import (
+	"math"
+	"testing"
+
+	"github.com/apidepot/digikey"
+)
+
+// PricingVector is a reference case for extended price calculations. Err is
+// nil when the order is valid and UnitPrice and ExtendedPrice are expected.
+type PricingVector struct {
+	Name                 string
+	Breaks               []digikey.PriceBreak
+	MinimumOrderQuantity int
+	ReelingFee           digikey.Money
+	Quantity             int
+	UnitPrice            digikey.Money
+	ExtendedPrice        digikey.Money
+	Err                  error
+}
+
+// PricingFunc calculates the extended price of an order, with the same
+// signature as digikey.ExtendedPrice.
+type PricingFunc func(breaks []digikey.PriceBreak, qty, moq int, reelingFee digikey.Money) (digikey.Money, error)
+
+// price returns an amount without a currency, as the API returns prices.
+func price(amount float64) digikey.Money {
+	return digikey.Money{Amount: amount}
+}
+
+var (
+	cutTapeBreaks = []digikey.PriceBreak{
+		{BreakQuantity: 1, UnitPrice: price(0.10), TotalPrice: price(0.10)},
+		{BreakQuantity: 10, UnitPrice: price(0.061), TotalPrice: price(0.61)},
+		{BreakQuantity: 100, UnitPrice: price(0.0293), TotalPrice: price(2.93)},
+		{BreakQuantity: 1000, UnitPrice: price(0.01504), TotalPrice: price(15.04)},
+	}
+	reelBreaks = []digikey.PriceBreak{
+		{BreakQuantity: 5000, UnitPrice: price(0.00868), TotalPrice: price(43.40)},
+		{BreakQuantity: 10000, UnitPrice: price(0.00791), TotalPrice: price(79.10)},
+	}
+	unsortedBreaks = []digikey.PriceBreak{
+		{BreakQuantity: 25, UnitPrice: price(1.852), TotalPrice: price(46.30)},
+		{BreakQuantity: 1, UnitPrice: price(2.43), TotalPrice: price(2.43)},
+		{BreakQuantity: 100, UnitPrice: price(1.5431), TotalPrice: price(154.31)},
+	}
+)
+
+// PricingVectors are the reference cases implementations of pricing math
+// are expected to agree with.
+var PricingVectors = []PricingVector{
+	{Name: "single unit", Breaks: cutTapeBreaks, Quantity: 1, UnitPrice: price(0.10), ExtendedPrice: price(0.10)},
+	{Name: "just below break", Breaks: cutTapeBreaks, Quantity: 9, UnitPrice: price(0.10), ExtendedPrice: price(0.90)},
+	{Name: "exactly at break", Breaks: cutTapeBreaks, Quantity: 10, UnitPrice: price(0.061), ExtendedPrice: price(0.61)},
+	{Name: "between breaks rounds to cent", Breaks: cutTapeBreaks, Quantity: 99, UnitPrice: price(0.061), ExtendedPrice: price(6.04)},
+	{Name: "third break", Breaks: cutTapeBreaks, Quantity: 100, UnitPrice: price(0.0293), ExtendedPrice: price(2.93)},
+	{Name: "above last break", Breaks: cutTapeBreaks, Quantity: 2500, UnitPrice: price(0.01504), ExtendedPrice: price(37.60)},
+	{Name: "digi-reel fee", Breaks: cutTapeBreaks, ReelingFee: price(7), Quantity: 100, UnitPrice: price(0.0293), ExtendedPrice: price(9.93)},
+	{Name: "reel at minimum", Breaks: reelBreaks, MinimumOrderQuantity: 5000, Quantity: 5000, UnitPrice: price(0.00868), ExtendedPrice: price(43.40)},
+	{Name: "reel above minimum", Breaks: reelBreaks, MinimumOrderQuantity: 5000, Quantity: 12000, UnitPrice: price(0.00791), ExtendedPrice: price(94.92)},
+	{Name: "reel below minimum", Breaks: reelBreaks, MinimumOrderQuantity: 5000, Quantity: 1000, Err: digikey.ErrBelowMinimumOrderQuantity},
+	{Name: "below first break", Breaks: reelBreaks, Quantity: 10, Err: digikey.ErrBelowMinimumOrderQuantity},
+	{Name: "unsorted breaks", Breaks: unsortedBreaks, Quantity: 30, UnitPrice: price(1.852), ExtendedPrice: price(55.56)},
+	{Name: "minimum above first break", Breaks: unsortedBreaks, MinimumOrderQuantity: 10, Quantity: 5, Err: digikey.ErrBelowMinimumOrderQuantity},
+	{Name: "zero quantity", Breaks: cutTapeBreaks, Quantity: 0, Err: digikey.ErrInvalidQuantity},
+	{Name: "negative quantity", Breaks: cutTapeBreaks, Quantity: -5, Err: digikey.ErrInvalidQuantity},
+}
+
+// VerifyPricing runs fn against every pricing vector and reports each
+// vector it disagrees with as an error of t. Extended prices are compared to
+// within half a cent. Expected errors only need to be matched by fn
+// returning any error.
+func VerifyPricing(t testing.TB, fn PricingFunc) {
+	t.Helper()
+	for _, v := range PricingVectors {
+		got, err := fn(v.Breaks, v.Quantity, v.MinimumOrderQuantity, v.ReelingFee)
+		switch {
+		case v.Err != nil && err == nil:
+			t.Errorf("%s: got %.2f, want error %q", v.Name, got.Amount, v.Err)
+		case v.Err == nil && err != nil:
+			t.Errorf("%s: unexpected error: %v", v.Name, err)
+		case v.Err == nil && math.Abs(got.Amount-v.ExtendedPrice.Amount) >= 0.005:
+			t.Errorf("%s: got %.2f, want %.2f", v.Name, got.Amount, v.ExtendedPrice.Amount)
+		}
+	}
+}
@@ -0,0 +1,671 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikeytest
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/apidepot/digikey"
+)
+
+// searchPath is the path prefix of the Product Information V4 API.
+const searchPath = "/products/v4/search/"
+
+// Request is a request received by a Server.
+type Request struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Header http.Header
+	Body   []byte
+}
+
+// Server is a fake DigiKey API serving a catalog of products. It implements
+// the token endpoint and the product search, details, pricing, category,
+// and manufacturer endpoints, and can simulate rate limiting, an exhausted
+// daily quota, and failed requests. Other endpoints respond 404 Not Found.
+type Server struct {
+	*httptest.Server
+
+	// Tokens is the server's token endpoint. It shares the server's
+	// listener and can be used to expire tokens or rotate the secret.
+	Tokens *TokenServer
+
+	mu          sync.Mutex
+	clientID    string
+	products    []digikey.Product
+	rateLimit   int
+	rateWindow  time.Duration
+	windowStart time.Time
+	windowCount int
+	dailyLimit  int
+	dailyUsed   int
+	failures    []failure
+	requests    []Request
+}
+
+// failure is a queued error response.
+type failure struct {
+	status int
+	body   string
+}
+
+// NewServer starts a fake API server that accepts the given client ID and
+// secret and serves the products, or DefaultCatalog if none are given. The
+// caller should call Close when finished.
+func NewServer(clientID, secret string, products ...digikey.Product) *Server {
+	if len(products) == 0 {
+		products = DefaultCatalog()
+	}
+	s := &Server{
+		clientID: clientID,
+		Tokens:   newTokenServer(clientID, secret),
+		products: products,
+	}
+	s.Server = httptest.NewServer(s)
+	s.Tokens.Server = s.Server
+	return s
+}
+
+// ClientOptions returns the options that point a client at the server and
+// disable client-side rate limiting.
+func (s *Server) ClientOptions() []digikey.ClientOption {
+	return []digikey.ClientOption{
+		digikey.WithBaseURL(s.URL + "/"),
+		digikey.WithTokenURL(s.Tokens.TokenURL()),
+		digikey.WithNoRateLimit(),
+	}
+}
+
+// NewClient creates a client of the server with the given credentials and
+// any additional options.
+func (s *Server) NewClient(clientID, secret string, opts ...digikey.ClientOption) (*digikey.Client, error) {
+	return digikey.NewClient(clientID, secret, append(s.ClientOptions(), opts...)...)
+}
+
+// AddProducts adds products to the catalog.
+func (s *Server) AddProducts(products ...digikey.Product) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.products = append(s.products, products...)
+}
+
+// SetProducts replaces the catalog.
+func (s *Server) SetProducts(products ...digikey.Product) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.products = products
+}
+
+// SetRateLimit responds 429 Too Many Requests to requests beyond n in each
+// window. A limit of zero disables rate limiting.
+func (s *Server) SetRateLimit(n int, window time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rateLimit, s.rateWindow = n, window
+	s.windowStart, s.windowCount = time.Time{}, 0
+}
+
+// SetDailyQuota reports the quota in the X-RateLimit-Limit and
+// X-RateLimit-Remaining headers and responds 429 Too Many Requests once it
+// is used up. A quota of zero disables the quota.
+func (s *Server) SetDailyQuota(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.dailyLimit, s.dailyUsed = n, 0
+}
+
+// FailNext responds to the next API request with the status and body, in
+// the order queued. Token requests are not affected.
+func (s *Server) FailNext(status int, body string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = append(s.failures, failure{status, body})
+}
+
+// Requests returns the API requests the server has received, excluding
+// token requests, in order.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.requests)
+}
+
+// RequestCount returns the number of API requests with the method and path.
+func (s *Server) RequestCount(method, path string) int {
+	n := 0
+	for _, r := range s.Requests() {
+		if r.Method == method && r.Path == path {
+			n++
+		}
+	}
+	return n
+}
+
+// AssertRequested reports a test error unless the server received exactly
+// n requests with the method and path, e.g.
+// "/products/v4/search/keyword".
+func (s *Server) AssertRequested(t testing.TB, method, path string, n int) {
+	t.Helper()
+	if got := s.RequestCount(method, path); got != n {
+		t.Errorf("got %d %s %s requests, want %d", got, method, path, n)
+	}
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == TokenPath {
+		s.Tokens.ServeHTTP(w, r)
+		return
+	}
+
+	body, _ := io.ReadAll(r.Body)
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{
+		Method: r.Method,
+		Path:   r.URL.Path,
+		Query:  r.URL.Query(),
+		Header: r.Header.Clone(),
+		Body:   body,
+	})
+	s.mu.Unlock()
+
+	if !s.Tokens.Active(strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")) {
+		http.Error(w, `{"ErrorMessage":"Bearer token invalid or expired"}`, http.StatusUnauthorized)
+		return
+	}
+	if r.Header.Get("X-DIGIKEY-Client-Id") != s.clientID {
+		http.Error(w, `{"ErrorMessage":"Invalid client ID"}`, http.StatusUnauthorized)
+		return
+	}
+	if !s.admit(w) {
+		return
+	}
+
+	rest, ok := strings.CutPrefix(r.URL.Path, searchPath)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	switch {
+	case rest == "keyword" && r.Method == http.MethodPost:
+		s.keyword(w, body)
+	case rest == "categories" && r.Method == http.MethodGet:
+		writeJSON(w, map[string]any{"Categories": s.categories()})
+	case strings.HasPrefix(rest, "categories/") && r.Method == http.MethodGet:
+		s.category(w, r, strings.TrimPrefix(rest, "categories/"))
+	case rest == "manufacturers" && r.Method == http.MethodGet:
+		writeJSON(w, map[string]any{"Manufacturers": s.manufacturers()})
+	case r.Method == http.MethodGet:
+		s.product(w, r, rest)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// admit applies the queued failures, rate limit, and quota to a request,
+// writing the error response and returning false if it is rejected.
+func (s *Server) admit(w http.ResponseWriter) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.failures) > 0 {
+		f := s.failures[0]
+		s.failures = s.failures[1:]
+		http.Error(w, f.body, f.status)
+		return false
+	}
+	if s.rateLimit > 0 {
+		now := time.Now()
+		if now.Sub(s.windowStart) >= s.rateWindow {
+			s.windowStart, s.windowCount = now, 0
+		}
+		if s.windowCount >= s.rateLimit {
+			retry := s.windowStart.Add(s.rateWindow).Sub(now)
+			w.Header().Set("Retry-After", strconv.Itoa(int(retry.Seconds())+1))
+			http.Error(w, `{"ErrorMessage":"Rate limit exceeded"}`, http.StatusTooManyRequests)
+			return false
+		}
+		s.windowCount++
+	}
+	if s.dailyLimit > 0 {
+		if s.dailyUsed >= s.dailyLimit {
+			w.Header().Set("X-RateLimit-Limit", strconv.Itoa(s.dailyLimit))
+			w.Header().Set("X-RateLimit-Remaining", "0")
+			http.Error(w, `{"ErrorMessage":"Daily quota exceeded"}`, http.StatusTooManyRequests)
+			return false
+		}
+		s.dailyUsed++
+		w.Header().Set("X-RateLimit-Limit", strconv.Itoa(s.dailyLimit))
+		w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(s.dailyLimit-s.dailyUsed))
+	}
+	return true
+}
+
+// keyword serves a keyword search.
+func (s *Server) keyword(w http.ResponseWriter, body []byte) {
+	var req digikey.KeywordRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		http.Error(w, fmt.Sprintf(`{"ErrorMessage":%q}`, err.Error()), http.StatusBadRequest)
+		return
+	}
+	s.mu.Lock()
+	products := slices.Clone(s.products)
+	s.mu.Unlock()
+
+	terms := strings.Fields(strings.ToLower(req.Keywords))
+	var matches, exact []digikey.Product
+	for _, p := range products {
+		if !matchesTerms(p, terms) || !matchesFilters(p, req.FilterOptionsRequest) {
+			continue
+		}
+		matches = append(matches, p)
+		if req.Keywords != "" && findProduct([]digikey.Product{p}, strings.TrimSpace(req.Keywords)) != nil {
+			exact = append(exact, p)
+		}
+	}
+	if req.SortOptions != nil {
+		sortProducts(matches, *req.SortOptions)
+	}
+
+	limit := req.Limit
+	if limit <= 0 || limit > digikey.MaxSearchLimit {
+		limit = digikey.MaxSearchLimit
+	}
+	page := matches[min(max(req.Offset, 0), len(matches)):]
+	page = page[:min(limit, len(page))]
+	resp := digikey.KeywordResponse{
+		Products:      nonNil(page),
+		ProductsCount: len(matches),
+		ExactMatches:  nonNil(exact),
+		FilterOptions: filterOptions(matches),
+	}
+	if len(matches) == 0 {
+		resp.Suggestions = suggest(products, strings.TrimSpace(req.Keywords))
+	}
+	writeJSON(w, resp)
+}
+
+// maxSuggestionDistance is the largest edit distance between the keywords
+// and a manufacturer product number that the server suggests.
+const maxSuggestionDistance = 2
+
+// suggest returns the manufacturer product numbers of the products within
+// maxSuggestionDistance edits of the keywords, closest first.
+func suggest(products []digikey.Product, keywords string) []string {
+	if keywords == "" {
+		return nil
+	}
+	type suggestion struct {
+		mpn  string
+		dist int
+	}
+	var found []suggestion
+	for _, p := range products {
+		d := editDistance(strings.ToLower(keywords), strings.ToLower(p.ManufacturerProductNumber))
+		if d <= maxSuggestionDistance {
+			found = append(found, suggestion{p.ManufacturerProductNumber, d})
+		}
+	}
+	slices.SortStableFunc(found, func(a, b suggestion) int {
+		return cmp.Compare(a.dist, b.dist)
+	})
+	var mpns []string
+	for _, f := range found {
+		mpns = append(mpns, f.mpn)
+	}
+	return mpns
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
+// product serves the endpoints of a single product.
+func (s *Server) product(w http.ResponseWriter, r *http.Request, rest string) {
+	escaped, resource, ok := strings.Cut(rest, "/")
+	pn, err := url.PathUnescape(escaped)
+	if !ok || err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	s.mu.Lock()
+	p := findProduct(s.products, pn)
+	s.mu.Unlock()
+	if p == nil {
+		http.Error(w, `{"ErrorMessage":"Product not found"}`, http.StatusNotFound)
+		return
+	}
+	switch resource {
+	case "productdetails":
+		writeJSON(w, map[string]any{"Product": p})
+	case "pricing":
+		writeJSON(w, map[string]any{
+			"ProductsCount": 1,
+			"ProductPricings": []digikey.ProductPricing{{
+				ManufacturerProductNumber:  p.ManufacturerProductNumber,
+				Manufacturer:               p.Manufacturer,
+				Description:                p.Description,
+				QuantityAvailable:          p.QuantityAvailable,
+				ProductURL:                 p.ProductURL,
+				IsDiscontinued:             p.Discontinued,
+				NormallyStocking:           p.NormallyStocking,
+				IsObsolete:                 p.EndOfLife,
+				ManufacturerLeadWeeks:      p.ManufacturerLeadWeeks,
+				ManufacturerPublicQuantity: p.ManufacturerPublicQuantity,
+				ProductVariations:          p.ProductVariations,
+			}},
+		})
+	case "substitutions":
+		writeJSON(w, map[string]any{"ProductSubstitutesCount": 0, "ProductSubstitutes": []any{}})
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// category serves a category by ID.
+func (s *Server) category(w http.ResponseWriter, r *http.Request, id string) {
+	n, err := strconv.Atoi(id)
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	c, ok := digikey.FindCategoryByID(s.categories(), n)
+	if !ok {
+		http.Error(w, `{"ErrorMessage":"Category not found"}`, http.StatusNotFound)
+		return
+	}
+	writeJSON(w, map[string]any{"Category": c})
+}
+
+// categories returns the categories of the catalog's products, with their
+// product counts.
+func (s *Server) categories() []digikey.Category {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var categories []digikey.Category
+	for _, p := range s.products {
+		i := slices.IndexFunc(categories, func(c digikey.Category) bool {
+			return c.CategoryID == p.Category.CategoryID
+		})
+		if i < 0 {
+			c := p.Category
+			c.ProductCount = 0
+			categories = append(categories, c)
+			i = len(categories) - 1
+		}
+		categories[i].ProductCount++
+	}
+	return nonNil(categories)
+}
+
+// manufacturers returns the manufacturers of the catalog's products.
+func (s *Server) manufacturers() []digikey.Manufacturer {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var manufacturers []digikey.Manufacturer
+	for _, p := range s.products {
+		if !slices.Contains(manufacturers, p.Manufacturer) {
+			manufacturers = append(manufacturers, p.Manufacturer)
+		}
+	}
+	return nonNil(manufacturers)
+}
+
+// findProduct returns the product with the manufacturer or DigiKey product
+// number, ignoring case.
+func findProduct(products []digikey.Product, pn string) *digikey.Product {
+	for i, p := range products {
+		if strings.EqualFold(p.ManufacturerProductNumber, pn) {
+			return &products[i]
+		}
+		for _, v := range p.ProductVariations {
+			if strings.EqualFold(v.DigiKeyProductNumber, pn) {
+				return &products[i]
+			}
+		}
+	}
+	return nil
+}
+
+// matchesTerms reports whether every term appears in the product's part
+// numbers, manufacturer, or description.
+func matchesTerms(p digikey.Product, terms []string) bool {
+	fields := []string{p.ManufacturerProductNumber, p.Manufacturer.Name, p.Description.ProductDescription, p.Description.DetailedDescription}
+	for _, v := range p.ProductVariations {
+		fields = append(fields, v.DigiKeyProductNumber)
+	}
+	text := strings.ToLower(strings.Join(fields, " "))
+	for _, t := range terms {
+		if !strings.Contains(text, t) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchesFilters reports whether the product passes the filters.
+func matchesFilters(p digikey.Product, f *digikey.FilterOptionsRequest) bool {
+	if f == nil {
+		return true
+	}
+	if slices.Contains(f.SearchOptions, "InStock") && p.QuantityAvailable == 0 {
+		return false
+	}
+	if slices.Contains(f.SearchOptions, "NormallyStocking") && !p.NormallyStocking {
+		return false
+	}
+	if p.QuantityAvailable < f.MinimumQuantityAvailable {
+		return false
+	}
+	if len(f.PackagingFilter) > 0 && !slices.ContainsFunc(p.ProductVariations, func(v digikey.ProductVariation) bool {
+		return matchesIDs(f.PackagingFilter, v.PackageType.ID)
+	}) {
+		return false
+	}
+	return matchesIDs(f.ManufacturerFilter, p.Manufacturer.ID) &&
+		matchesIDs(f.CategoryFilter, p.Category.CategoryID) &&
+		matchesIDs(f.StatusFilter, p.ProductStatus.ID) &&
+		matchesIDs(f.SeriesFilter, p.Series.ID) &&
+		matchesParameters(p, f.ParameterFilterRequest)
+}
+
+// matchesParameters reports whether the product is in the category of the
+// parametric filters and has one of the values of each.
+func matchesParameters(p digikey.Product, f *digikey.ParameterFilterRequest) bool {
+	if f == nil {
+		return true
+	}
+	if f.CategoryFilter.ID != "" && !matchesIDs([]digikey.FilterID{f.CategoryFilter}, p.Category.CategoryID) {
+		return false
+	}
+	for _, pf := range f.ParameterFilters {
+		if !slices.ContainsFunc(p.Parameters, func(param digikey.Parameter) bool {
+			return param.ParameterID == pf.ParameterID && slices.Contains(pf.FilterValues, digikey.FilterID{ID: param.ValueID})
+		}) {
+			return false
+		}
+	}
+	return true
+}
+
+// filterOptions returns the filters available to narrow the matches, with
+// the number of matches for each. Parametric filters are only offered when
+// the matches share a category, as DigiKey does.
+func filterOptions(matches []digikey.Product) digikey.FilterOptions {
+	var opts digikey.FilterOptions
+	var manufacturers, packaging, status, series counter
+	categories := map[int]*digikey.TopCategory{}
+	params := map[int]*digikey.ParametricFilterOption{}
+	var paramOrder []int
+	for _, p := range matches {
+		manufacturers.add(p.Manufacturer.ID, p.Manufacturer.Name)
+		status.add(p.ProductStatus.ID, p.ProductStatus.Status)
+		if p.Series.Name != "" {
+			series.add(p.Series.ID, p.Series.Name)
+		}
+		seen := map[int]bool{}
+		for _, v := range p.ProductVariations {
+			if !seen[v.PackageType.ID] {
+				seen[v.PackageType.ID] = true
+				packaging.add(v.PackageType.ID, v.PackageType.Name)
+			}
+		}
+		c := categories[p.Category.CategoryID]
+		if c == nil {
+			node := digikey.TopCategoryNode{ID: p.Category.CategoryID, Name: p.Category.Name}
+			c = &digikey.TopCategory{RootCategory: node, Category: node}
+			categories[p.Category.CategoryID] = c
+		}
+		c.Category.ProductCount++
+		c.RootCategory.ProductCount++
+	}
+	opts.Manufacturers = manufacturers.filters()
+	opts.Packaging = packaging.filters()
+	opts.Status = status.filters()
+	opts.Series = series.filters()
+	for _, c := range categories {
+		c.Score = float64(c.Category.ProductCount) / float64(len(matches))
+		opts.TopCategories = append(opts.TopCategories, *c)
+	}
+	slices.SortFunc(opts.TopCategories, func(a, b digikey.TopCategory) int {
+		return cmp.Or(cmp.Compare(b.Category.ProductCount, a.Category.ProductCount), cmp.Compare(a.Category.ID, b.Category.ID))
+	})
+	if len(categories) != 1 {
+		return opts
+	}
+	category := digikey.BaseFilter{ID: matches[0].Category.CategoryID, Value: matches[0].Category.Name, ProductCount: len(matches)}
+	for _, p := range matches {
+		for _, param := range p.Parameters {
+			if param.ValueID == "" {
+				continue
+			}
+			pf := params[param.ParameterID]
+			if pf == nil {
+				pf = &digikey.ParametricFilterOption{
+					Category:      category,
+					ParameterType: param.ParameterType,
+					ParameterID:   param.ParameterID,
+					ParameterName: param.ParameterText,
+				}
+				params[param.ParameterID] = pf
+				paramOrder = append(paramOrder, param.ParameterID)
+			}
+			i := slices.IndexFunc(pf.FilterValues, func(v digikey.FilterValue) bool { return v.ValueID == param.ValueID })
+			if i < 0 {
+				pf.FilterValues = append(pf.FilterValues, digikey.FilterValue{ValueID: param.ValueID, ValueName: param.ValueText})
+				i = len(pf.FilterValues) - 1
+			}
+			pf.FilterValues[i].ProductCount++
+		}
+	}
+	for _, id := range paramOrder {
+		opts.ParametricFilters = append(opts.ParametricFilters, *params[id])
+	}
+	return opts
+}
+
+// counter counts the products having each filter value, in the order the
+// values are first seen.
+type counter struct {
+	values []digikey.BaseFilter
+}
+
+func (c *counter) add(id int, value string) {
+	for i := range c.values {
+		if c.values[i].ID == id {
+			c.values[i].ProductCount++
+			return
+		}
+	}
+	c.values = append(c.values, digikey.BaseFilter{ID: id, Value: value, ProductCount: 1})
+}
+
+func (c *counter) filters() []digikey.BaseFilter {
+	return nonNil(c.values)
+}
+
+// matchesIDs reports whether the filter is empty or contains id.
+func matchesIDs(filter []digikey.FilterID, id int) bool {
+	if len(filter) == 0 {
+		return true
+	}
+	return slices.ContainsFunc(filter, func(f digikey.FilterID) bool {
+		return f.ID == strconv.Itoa(id)
+	})
+}
+
+// sortProducts sorts the products in place.
+func sortProducts(products []digikey.Product, o digikey.SortOptions) {
+	slices.SortStableFunc(products, func(a, b digikey.Product) int {
+		var c int
+		switch o.Field {
+		case digikey.SortByPrice:
+			c = cmp.Compare(a.UnitPrice.Amount, b.UnitPrice.Amount)
+		case digikey.SortByQuantityAvailable:
+			c = cmp.Compare(a.QuantityAvailable, b.QuantityAvailable)
+		case digikey.SortByManufacturer:
+			c = cmp.Compare(a.Manufacturer.Name, b.Manufacturer.Name)
+		case digikey.SortByDigiKeyProductNumber:
+			c = cmp.Compare(firstDigiKeyPartNumber(a), firstDigiKeyPartNumber(b))
+		case digikey.SortByManufacturerProductNumber:
+			c = cmp.Compare(a.ManufacturerProductNumber, b.ManufacturerProductNumber)
+		}
+		if o.SortOrder == digikey.Descending {
+			c = -c
+		}
+		return c
+	})
+}
+
+func firstDigiKeyPartNumber(p digikey.Product) string {
+	if len(p.ProductVariations) == 0 {
+		return ""
+	}
+	return p.ProductVariations[0].DigiKeyProductNumber
+}
+
+// nonNil returns s, or an empty slice if s is nil, so that it is encoded
+// as an empty JSON array like the real API's responses.
+func nonNil[T any](s []T) []T {
+	if s == nil {
+		return []T{}
+	}
+	return s
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,165 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikeytest_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/apidepot/digikey"
+	"github.com/apidepot/digikey/digikeytest"
+)
+
+func newClient(t *testing.T, srv *digikeytest.Server) *digikey.Client {
+	t.Helper()
+	c, err := srv.NewClient("id", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+// statusOf returns the status code of an API error, or 0.
+func statusOf(err error) int {
+	var apiErr digikey.Error
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode
+	}
+	return 0
+}
+
+func TestServerCatalog(t *testing.T) {
+	srv := digikeytest.NewServer("id", "secret")
+	defer srv.Close()
+	c := newClient(t, srv)
+	ctx := context.Background()
+
+	resp, err := c.Products.KeywordSearch(ctx, digikey.KeywordRequest{Keywords: "lm358"})
+	if err != nil || len(resp.Products) != 1 || resp.Products[0].ManufacturerProductNumber != "LM358DR" {
+		t.Errorf("KeywordSearch(lm358) = %+v, %v, want LM358DR", resp, err)
+	}
+	for _, pn := range []string{"LM358DR", "296-1395-1-ND"} {
+		if p, err := c.Products.ProductDetails(ctx, pn); err != nil || p.ManufacturerProductNumber != "LM358DR" {
+			t.Errorf("ProductDetails(%s) = %v, %v, want LM358DR", pn, p, err)
+		}
+	}
+	if _, err := c.Products.ProductDetails(ctx, "NO-SUCH-PART"); statusOf(err) != http.StatusNotFound {
+		t.Errorf("ProductDetails of an unknown part = %v, want 404", err)
+	}
+
+	srv.SetProducts()
+	resp, err = c.Products.KeywordSearch(ctx, digikey.KeywordRequest{Keywords: "lm358"})
+	if err != nil || len(resp.Products) != 0 {
+		t.Errorf("KeywordSearch() of an empty catalog = %+v, %v, want no products", resp, err)
+	}
+	srv.AssertRequested(t, http.MethodPost, "/products/v4/search/keyword", 2)
+}
+
+func TestServerAuth(t *testing.T) {
+	srv := digikeytest.NewServer("id", "secret")
+	defer srv.Close()
+	if _, err := srv.NewClient("id", "wrong"); err == nil {
+		t.Error("NewClient() with the wrong secret succeeded")
+	}
+	if _, err := srv.NewClient("other", "secret"); err == nil {
+		t.Error("NewClient() with the wrong client ID succeeded")
+	}
+
+	// Requests need an active token from the server.
+	c, err := digikey.NewClient("id", "secret", append(srv.ClientOptions(), digikey.WithAccessToken("forged"))...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Products.ProductDetails(context.Background(), "LM358DR"); statusOf(err) != http.StatusUnauthorized {
+		t.Errorf("request with a forged token = %v, want 401", err)
+	}
+	srv.Tokens.SetExpiresIn(0)
+	c = newClient(t, srv)
+	if _, err := c.Products.ProductDetails(context.Background(), "LM358DR"); statusOf(err) != http.StatusUnauthorized {
+		t.Errorf("request with an expired token = %v, want 401", err)
+	}
+}
+
+func TestServerFailNext(t *testing.T) {
+	srv := digikeytest.NewServer("id", "secret")
+	defer srv.Close()
+	c := newClient(t, srv)
+	srv.FailNext(http.StatusServiceUnavailable, "down")
+	srv.FailNext(http.StatusBadGateway, "still down")
+	ctx := context.Background()
+
+	for _, want := range []int{http.StatusServiceUnavailable, http.StatusBadGateway, 0} {
+		_, err := c.Products.ProductDetails(ctx, "LM358DR")
+		if statusOf(err) != want || (want == 0 && err != nil) {
+			t.Errorf("ProductDetails() = %v, want status %d", err, want)
+		}
+	}
+}
+
+func TestServerLimits(t *testing.T) {
+	srv := digikeytest.NewServer("id", "secret")
+	defer srv.Close()
+	c := newClient(t, srv)
+	ctx := context.Background()
+
+	srv.SetDailyQuota(2)
+	for range 2 {
+		if _, err := c.Products.ProductDetails(ctx, "LM358DR"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := c.Products.ProductDetails(ctx, "LM358DR"); statusOf(err) != http.StatusTooManyRequests {
+		t.Errorf("request beyond the daily quota = %v, want 429", err)
+	}
+	srv.SetDailyQuota(0)
+
+	srv.SetRateLimit(1, time.Minute)
+	if _, err := c.Products.ProductDetails(ctx, "LM358DR"); err != nil {
+		t.Fatal(err)
+	}
+	_, err := c.Products.ProductDetails(ctx, "LM358DR")
+	if wait, ok := digikey.RetryAfter(err); !ok || wait <= 0 || wait > time.Minute {
+		t.Errorf("request beyond the rate limit = %v, want 429 with a Retry-After of at most a minute", err)
+	}
+}
+
+// errorRecorder is a testing.TB recording the errors reported to it.
+type errorRecorder struct {
+	testing.TB
+	errors []string
+}
+
+func (r *errorRecorder) Helper() {}
+
+func (r *errorRecorder) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func TestAssertRequested(t *testing.T) {
+	srv := digikeytest.NewServer("id", "secret")
+	defer srv.Close()
+	c := newClient(t, srv)
+	c.Products.ProductDetails(context.Background(), "LM358DR")
+	path := "/products/v4/search/LM358DR/productdetails"
+
+	rec := &errorRecorder{TB: t}
+	srv.AssertRequested(rec, http.MethodGet, path, 1)
+	if len(rec.errors) != 0 {
+		t.Errorf("AssertRequested() of a made request reported %q", rec.errors)
+	}
+	srv.AssertRequested(rec, http.MethodGet, path, 2)
+	srv.AssertRequested(rec, http.MethodPost, path, 1)
+	if len(rec.errors) != 2 {
+		t.Errorf("AssertRequested() of wrong counts reported %d errors, want 2", len(rec.errors))
+	}
+	if reqs := srv.Requests(); len(reqs) != 1 || reqs[0].Header.Get("X-Digikey-Client-Id") != "id" {
+		t.Errorf("Requests() = %+v, want the one request with its headers", reqs)
+	}
+}
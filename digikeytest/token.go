@@ -0,0 +1,193 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+// Package digikeytest provides test doubles for the DigiKey API, so that
+// code using the digikey package can be tested without network access.
+package digikeytest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/apidepot/digikey"
+	"github.com/apidepot/digikey/internal/testhooks"
+)
+
+// TokenPath is the path of the OAuth2 token endpoint.
+const TokenPath = "/v1/oauth2/token"
+
+// TokenServer is a fake OAuth2 client credentials token endpoint. Its
+// behavior can be changed while it is running to simulate expired tokens,
+// slow responses, rotated secrets, and malformed response bodies.
+type TokenServer struct {
+	*httptest.Server
+
+	mu        sync.Mutex
+	clientID  string
+	secret    string
+	expiresIn int
+	delay     time.Duration
+	malformed bool
+	requests  int
+	issued    int
+	token     string
+	expiry    map[string]time.Time
+}
+
+// NewTokenServer starts a token server that issues tokens valid for ten
+// minutes to the given client ID and secret. The caller should call Close
+// when finished.
+func NewTokenServer(clientID, secret string) *TokenServer {
+	s := newTokenServer(clientID, secret)
+	s.Server = httptest.NewServer(s)
+	return s
+}
+
+// newTokenServer returns a token server that is not yet listening.
+func newTokenServer(clientID, secret string) *TokenServer {
+	return &TokenServer{
+		clientID:  clientID,
+		secret:    secret,
+		expiresIn: 600,
+	}
+}
+
+// TokenURL returns the URL of the token endpoint, for use with
+// digikey.WithTokenURL.
+func (s *TokenServer) TokenURL() string {
+	return s.URL + TokenPath
+}
+
+// SetExpiresIn sets the lifetime in seconds of subsequently issued tokens.
+// A value of zero or less issues tokens that are already expired.
+func (s *TokenServer) SetExpiresIn(seconds int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.expiresIn = seconds
+}
+
+// SetDelay delays each response by d, or until the request is cancelled.
+func (s *TokenServer) SetDelay(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.delay = d
+}
+
+// RotateSecret changes the client secret the server accepts. Clients using
+// the previous secret are rejected with 401 Unauthorized.
+func (s *TokenServer) RotateSecret(secret string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.secret = secret
+}
+
+// SetMalformed makes the server respond with a body that is not valid JSON.
+func (s *TokenServer) SetMalformed(malformed bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.malformed = malformed
+}
+
+// Requests returns the number of token requests the server has received.
+func (s *TokenServer) Requests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests
+}
+
+// Token returns the most recently issued access token.
+func (s *TokenServer) Token() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.token
+}
+
+// Valid reports whether token is the most recently issued access token.
+func (s *TokenServer) Valid(token string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return token != "" && token == s.token
+}
+
+// Active reports whether token was issued by the server and has not yet
+// expired. Unlike Valid, it accepts tokens superseded by later requests, as
+// the DigiKey API does.
+func (s *TokenServer) Active(token string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	expiry, ok := s.expiry[token]
+	return ok && time.Now().Before(expiry)
+}
+
+// ExpireToken marks the client's access token as expired, so that its next
+// request fetches a new one, for testing code that must survive token
+// renewal. It has no effect on a client using digikey.WithAccessToken.
+func ExpireToken(c *digikey.Client) {
+	testhooks.ExpireToken(c)
+}
+
+// ServeHTTP implements http.Handler.
+func (s *TokenServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests++
+	delay := s.delay
+	s.mu.Unlock()
+
+	// Reading the body before the delay lets the server notice a
+	// cancelled request.
+	err := r.ParseForm()
+	if delay > 0 {
+		select {
+		case <-time.After(delay):
+		case <-r.Context().Done():
+			return
+		}
+	}
+
+	if r.URL.Path != TokenPath {
+		http.NotFound(w, r)
+		return
+	}
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if r.PostForm.Get("grant_type") != "client_credentials" {
+		http.Error(w, `{"error":"unsupported_grant_type"}`, http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if r.PostForm.Get("client_id") != s.clientID || r.PostForm.Get("client_secret") != s.secret {
+		http.Error(w, `{"error":"invalid_client"}`, http.StatusUnauthorized)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if s.malformed {
+		fmt.Fprint(w, `{"access_token":`)
+		return
+	}
+	s.issued++
+	s.token = fmt.Sprintf("token-%d", s.issued)
+	if s.expiry == nil {
+		s.expiry = make(map[string]time.Time)
+	}
+	s.expiry[s.token] = time.Now().Add(time.Duration(s.expiresIn) * time.Second)
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"access_token": s.token,
+		"expires_in":   s.expiresIn,
+		"token_type":   "Bearer",
+	})
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikeytest_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/apidepot/digikey"
+	"github.com/apidepot/digikey/digikeytest"
+)
+
+func TestTokenServer(t *testing.T) {
+	ts := digikeytest.NewTokenServer("id", "secret")
+	defer ts.Close()
+	newClient := func(secret string) (*digikey.Client, error) {
+		return digikey.NewClient("id", secret, digikey.WithTokenURL(ts.TokenURL()))
+	}
+
+	c, err := newClient("secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	first := ts.Token()
+	if first != "token-1" || !ts.Valid(first) || !ts.Active(first) {
+		t.Errorf("Token() = %q, want an active token-1", first)
+	}
+	if until := time.Until(c.TokenExpiresAt()); until < 9*time.Minute || until > 10*time.Minute {
+		t.Errorf("token expires in %s, want ten minutes", until)
+	}
+
+	// A superseded token stays active until it expires.
+	ts.SetExpiresIn(0)
+	if _, err := newClient("secret"); err != nil {
+		t.Fatal(err)
+	}
+	if second := ts.Token(); ts.Valid(first) || !ts.Active(first) || ts.Active(second) {
+		t.Errorf("after issuing expired %q, Valid(%[2]q) = %t, Active(%[2]q) = %t, want false, true", second, first, ts.Valid(first), ts.Active(first))
+	}
+
+	ts.RotateSecret("rotated")
+	if _, err := newClient("secret"); err == nil {
+		t.Error("NewClient() with a rotated secret succeeded")
+	}
+	if _, err := newClient("rotated"); err != nil {
+		t.Errorf("NewClient() with the new secret = %v", err)
+	}
+	ts.SetMalformed(true)
+	if _, err := newClient("rotated"); err == nil {
+		t.Error("NewClient() with a malformed token response succeeded")
+	}
+	if ts.Requests() != 5 {
+		t.Errorf("Requests() = %d, want 5", ts.Requests())
+	}
+
+	// A delayed response is abandoned when the request is cancelled.
+	ts.SetMalformed(false)
+	ts.SetDelay(time.Hour)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if _, err := digikey.NewClientContext(ctx, "id", "rotated", digikey.WithTokenURL(ts.TokenURL())); err == nil || time.Since(start) > 5*time.Second {
+		t.Errorf("NewClientContext() of a delayed server = %v after %s, want a prompt error", err, time.Since(start))
+	}
+}
@@ -0,0 +1,269 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikeytest
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// Redacted replaces secrets in recorded interactions.
+const Redacted = "REDACTED"
+
+// ErrNoInteraction is returned by a replaying Recorder for a request that
+// matches no unused recorded interaction.
+var ErrNoInteraction = errors.New("no recorded interaction matches the request")
+
+// Mode is the mode of a Recorder.
+type Mode int
+
+// Recorder modes.
+const (
+	// ModeReplay serves requests from the cassette without network access.
+	ModeReplay Mode = iota
+	// ModeRecord sends requests and records the interactions, replacing
+	// the cassette when the recorder is stopped.
+	ModeRecord
+	// ModeAuto replays the cassette if it exists and records it otherwise.
+	ModeAuto
+)
+
+// Interaction is a recorded request and its response.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is a recorded HTTP request.
+type RecordedRequest struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+// RecordedResponse is a recorded HTTP response.
+type RecordedResponse struct {
+	StatusCode int         `json:"statusCode"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// Recorder is an http.RoundTripper that records API interactions to a
+// cassette file and replays them, so tests can run against real payloads
+// without credentials or network access. Secrets are scrubbed before
+// interactions are recorded: the authorization and client ID headers, the
+// client credentials of token requests, and the access tokens of token
+// responses. Compressed responses are recorded decompressed. Use it with
+// digikey.WithHTTPClient:
+//
+//	rec, err := digikeytest.NewRecorder("testdata/search.json", digikeytest.ModeAuto, nil)
+//	defer rec.Stop()
+//	c, err := digikey.NewClient(id, secret, digikey.WithHTTPClient(rec.Client()))
+type Recorder struct {
+	path      string
+	mode      Mode
+	transport http.RoundTripper
+
+	// Scrubbers are applied, in order, to each interaction before it is
+	// recorded, and to each request before it is matched during replay.
+	// They run after the built-in scrubbing.
+	Scrubbers []func(*Interaction)
+
+	mu           sync.Mutex
+	interactions []Interaction
+	used         []bool
+}
+
+// NewRecorder creates a recorder for the cassette at path. The transport
+// sends requests while recording, and defaults to http.DefaultTransport.
+func NewRecorder(path string, mode Mode, transport http.RoundTripper) (*Recorder, error) {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	r := &Recorder{path: path, mode: mode, transport: transport}
+	if mode == ModeRecord {
+		return r, nil
+	}
+	data, err := os.ReadFile(path)
+	if mode == ModeAuto && errors.Is(err, fs.ErrNotExist) {
+		r.mode = ModeRecord
+		return r, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading cassette: %w", err)
+	}
+	if err := json.Unmarshal(data, &r.interactions); err != nil {
+		return nil, fmt.Errorf("error parsing cassette %s: %w", path, err)
+	}
+	r.mode = ModeReplay
+	r.used = make([]bool, len(r.interactions))
+	return r, nil
+}
+
+// Mode returns the mode the recorder is operating in, which is never
+// ModeAuto.
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// Client returns an HTTP client using the recorder as its transport.
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// Stop writes the cassette if the recorder is recording.
+func (r *Recorder) Stop() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	data, err := json.MarshalIndent(r.interactions, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(r.path, append(data, '\n'), 0o644)
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	recorded := Interaction{Request: RecordedRequest{
+		Method: req.Method,
+		URL:    req.URL.String(),
+		Header: req.Header.Clone(),
+		Body:   string(body),
+	}}
+
+	if r.mode == ModeReplay {
+		r.scrub(&recorded)
+		return r.replay(req, recorded.Request)
+	}
+
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+	recorded.Response = RecordedResponse{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header.Clone(),
+		Body:       string(respBody),
+	}
+	if strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		// Compressed bytes do not survive as a JSON string, so the body is
+		// recorded decompressed and replayed as such.
+		zr, err := gzip.NewReader(bytes.NewReader(respBody))
+		if err != nil {
+			return nil, fmt.Errorf("error decompressing response body: %w", err)
+		}
+		plain, err := io.ReadAll(zr)
+		if err != nil {
+			return nil, fmt.Errorf("error decompressing response body: %w", err)
+		}
+		recorded.Response.Body = string(plain)
+		recorded.Response.Header.Del("Content-Encoding")
+	}
+	r.scrub(&recorded)
+	r.mu.Lock()
+	r.interactions = append(r.interactions, recorded)
+	r.mu.Unlock()
+	return resp, nil
+}
+
+// replay returns the response of the first unused interaction matching the
+// request.
+func (r *Recorder) replay(req *http.Request, rr RecordedRequest) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, in := range r.interactions {
+		if r.used[i] || in.Request.Method != rr.Method || in.Request.URL != rr.URL || in.Request.Body != rr.Body {
+			continue
+		}
+		r.used[i] = true
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", in.Response.StatusCode, http.StatusText(in.Response.StatusCode)),
+			StatusCode:    in.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        in.Response.Header.Clone(),
+			Body:          io.NopCloser(bytes.NewReader([]byte(in.Response.Body))),
+			ContentLength: int64(len(in.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+	return nil, fmt.Errorf("%w: %s %s", ErrNoInteraction, rr.Method, rr.URL)
+}
+
+// scrub removes secrets from the interaction.
+func (r *Recorder) scrub(in *Interaction) {
+	for _, h := range []string{"Authorization", "X-Digikey-Client-Id"} {
+		if in.Request.Header.Get(h) != "" {
+			in.Request.Header.Set(h, Redacted)
+		}
+	}
+	in.Response.Header.Del("Set-Cookie")
+	// Scrubbing may change the length of the body.
+	in.Response.Header.Del("Content-Length")
+
+	if form, err := url.ParseQuery(in.Request.Body); err == nil && form.Has("client_secret") {
+		for _, k := range []string{"client_id", "client_secret", "code", "refresh_token"} {
+			if form.Has(k) {
+				form.Set(k, Redacted)
+			}
+		}
+		in.Request.Body = form.Encode()
+	}
+
+	var token map[string]any
+	if json.Unmarshal([]byte(in.Response.Body), &token) == nil {
+		scrubbed := false
+		for _, k := range []string{"access_token", "refresh_token"} {
+			if _, ok := token[k]; ok {
+				token[k] = Redacted
+				scrubbed = true
+			}
+		}
+		if scrubbed {
+			data, _ := json.Marshal(token)
+			in.Response.Body = string(data)
+		}
+	}
+
+	for _, s := range r.Scrubbers {
+		s(in)
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikeytest_test
+
+import (
+	"compress/gzip"
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/apidepot/digikey"
+	"github.com/apidepot/digikey/digikeytest"
+)
+
+// gzipHandler compresses the responses of h for clients accepting gzip, as
+// the DigiKey API does.
+func gzipHandler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.Contains(r.Header.Get("Accept-Encoding"), "gzip") {
+			h.ServeHTTP(w, r)
+			return
+		}
+		w.Header().Set("Content-Encoding", "gzip")
+		zw := gzip.NewWriter(w)
+		defer zw.Close()
+		h.ServeHTTP(gzipResponseWriter{w, zw}, r)
+	})
+}
+
+type gzipResponseWriter struct {
+	http.ResponseWriter
+	zw *gzip.Writer
+}
+
+func (w gzipResponseWriter) Write(b []byte) (int, error) { return w.zw.Write(b) }
+
+// record records the interactions of looking up a product at the server,
+// as client id with the given secret, to a cassette and returns its path.
+func record(t *testing.T, srv *digikeytest.Server, id, secret string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	rec, err := digikeytest.NewRecorder(path, digikeytest.ModeRecord, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := srv.NewClient(id, secret, digikey.WithHTTPClient(rec.Client()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Products.ProductDetails(context.Background(), "296-1395-1-ND"); err != nil {
+		t.Fatal(err)
+	}
+	if err := rec.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// replay looks up the recorded product from the cassette as client id.
+func replay(t *testing.T, srv *digikeytest.Server, path, id, secret string) {
+	t.Helper()
+	rec, err := digikeytest.NewRecorder(path, digikeytest.ModeReplay, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := srv.NewClient(id, secret, digikey.WithHTTPClient(rec.Client()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := c.Products.ProductDetails(context.Background(), "296-1395-1-ND")
+	if err != nil {
+		t.Fatalf("replayed ProductDetails() = %v", err)
+	}
+	if p.ManufacturerProductNumber != "LM358DR" {
+		t.Errorf("replayed product = %s, want LM358DR", p.ManufacturerProductNumber)
+	}
+}
+
+func TestRecorderGzip(t *testing.T) {
+	srv := digikeytest.NewServer("id", "secret")
+	defer srv.Close()
+	srv.Config.Handler = gzipHandler(srv.Config.Handler)
+
+	path := record(t, srv, "id", "secret")
+	n := len(srv.Requests())
+	replay(t, srv, path, "id", "secret")
+	if len(srv.Requests()) != n {
+		t.Errorf("replay sent %d requests to the server, want none", len(srv.Requests())-n)
+	}
+}
+
+func TestRecorderRedacts(t *testing.T) {
+	const id, secret = "client-4711", "hunter2-client-secret"
+	srv := digikeytest.NewServer(id, secret)
+	defer srv.Close()
+
+	path := record(t, srv, id, secret)
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cassette := string(b)
+	for _, s := range []string{id, secret, srv.Tokens.Token()} {
+		if strings.Contains(cassette, s) {
+			t.Errorf("cassette contains %q:\n%s", s, cassette)
+		}
+	}
+	if !strings.Contains(cassette, digikeytest.Redacted) {
+		t.Errorf("cassette does not contain %q:\n%s", digikeytest.Redacted, cassette)
+	}
+	replay(t, srv, path, id, secret)
+}
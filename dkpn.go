@@ -0,0 +1,148 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// ErrInvalidDKPN is returned for a string that is not a DigiKey product
+// number.
+var ErrInvalidDKPN = errors.New("invalid DigiKey product number")
+
+// dkpnSuffix is the suffix every DigiKey product number ends with.
+const dkpnSuffix = "-ND"
+
+// dkpnStyle is how a DigiKey product number encodes its packaging.
+type dkpnStyle int
+
+const (
+	// dkpnPlain has no packaging suffix, e.g. "LM358DR-ND".
+	dkpnPlain dkpnStyle = iota
+	// dkpnNumeric has a numeric suffix, e.g. "296-1395-1-ND" for cut tape.
+	dkpnNumeric
+	// dkpnLetters has a letter suffix, e.g. "296-LM358DRCT-ND" for cut
+	// tape.
+	dkpnLetters
+)
+
+// dkpnNumericSuffixes and dkpnLetterSuffixes map the packaging suffixes of
+// the two styles to packaging types.
+var (
+	dkpnNumericSuffixes = map[string]PackagingType{"-1": CutTape, "-2": TapeAndReel, "-6": DigiReel}
+	dkpnLetterSuffixes  = map[string]PackagingType{"CT": CutTape, "TR": TapeAndReel, "DKR": DigiReel}
+)
+
+// DKPN is a parsed DigiKey product number. The packaging options of a
+// product share a base and differ in their packaging suffix, e.g.
+// "296-1395-1-ND" (cut tape) and "296-1395-2-ND" (tape and reel).
+type DKPN struct {
+	// Base is the product number without its packaging suffix and "-ND",
+	// e.g. "296-1395".
+	Base string
+	// Packaging is the packaging the suffix denotes, or zero if the number
+	// has no recognized packaging suffix.
+	Packaging PackagingType
+
+	style dkpnStyle
+}
+
+// ParseDKPN validates and parses a DigiKey product number, ignoring case
+// and surrounding whitespace. Packaging suffixes are recognized in both the
+// numeric style, "-1-ND", "-2-ND", and "-6-ND", and the letter style,
+// "CT-ND", "TR-ND", and "DKR-ND". A letter suffix cannot be told apart from
+// a base that ends in the same letters, so it is always taken as one.
+func ParseDKPN(s string) (DKPN, error) {
+	pn := strings.ToUpper(strings.TrimSpace(s))
+	base, ok := strings.CutSuffix(pn, dkpnSuffix)
+	if !ok {
+		return DKPN{}, fmt.Errorf("%w: %q does not end in %s", ErrInvalidDKPN, s, dkpnSuffix)
+	}
+	if base == "" {
+		return DKPN{}, fmt.Errorf("%w: %q is empty", ErrInvalidDKPN, s)
+	}
+	for _, r := range base {
+		if r > unicode.MaxASCII || !unicode.IsPrint(r) || unicode.IsSpace(r) {
+			return DKPN{}, fmt.Errorf("%w: %q contains %q", ErrInvalidDKPN, s, r)
+		}
+	}
+	for suffix, t := range dkpnNumericSuffixes {
+		if b, ok := strings.CutSuffix(base, suffix); ok && b != "" {
+			return DKPN{Base: b, Packaging: t, style: dkpnNumeric}, nil
+		}
+	}
+	// No suffix ends with another, so the map order does not matter.
+	for suffix, t := range dkpnLetterSuffixes {
+		if b, ok := strings.CutSuffix(base, suffix); ok && b != "" {
+			return DKPN{Base: b, Packaging: t, style: dkpnLetters}, nil
+		}
+	}
+	return DKPN{Base: base}, nil
+}
+
+// NormalizeDKPN returns the DigiKey product number in canonical form: upper
+// case, without surrounding whitespace.
+func NormalizeDKPN(s string) (string, error) {
+	d, err := ParseDKPN(s)
+	if err != nil {
+		return "", err
+	}
+	return d.String(), nil
+}
+
+// String returns the DigiKey product number.
+func (d DKPN) String() string {
+	return d.Base + d.suffix(d.Packaging) + dkpnSuffix
+}
+
+// suffix returns the packaging suffix of t in the number's style.
+func (d DKPN) suffix(t PackagingType) string {
+	var suffixes map[string]PackagingType
+	switch d.style {
+	case dkpnNumeric:
+		suffixes = dkpnNumericSuffixes
+	case dkpnLetters:
+		suffixes = dkpnLetterSuffixes
+	}
+	for s, st := range suffixes {
+		if st == t {
+			return s
+		}
+	}
+	return ""
+}
+
+// WithPackaging returns the DigiKey product number of the same base part in
+// the given packaging, e.g. "296-1395-6-ND" for Digi-Reel from
+// "296-1395-1-ND". Only cut tape, tape and reel, and Digi-Reel have
+// predictable numbers, and only when the number has a packaging suffix.
+func (d DKPN) WithPackaging(t PackagingType) (DKPN, error) {
+	if d.style == dkpnPlain {
+		return DKPN{}, fmt.Errorf("%w: %s has no packaging variants", ErrInvalidDKPN, d)
+	}
+	if d.suffix(t) == "" {
+		return DKPN{}, fmt.Errorf("%w: no %s variant of %s", ErrInvalidDKPN, t, d)
+	}
+	d.Packaging = t
+	return d, nil
+}
+
+// SameBasePart reports whether two DigiKey product numbers are packaging
+// variants of the same part, e.g. "296-1395-1-ND" and "296-1395-2-ND".
+func SameBasePart(a, b string) bool {
+	da, err := ParseDKPN(a)
+	if err != nil {
+		return false
+	}
+	db, err := ParseDKPN(b)
+	if err != nil {
+		return false
+	}
+	return da.Base == db.Base && da.style == db.style
+}
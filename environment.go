@@ -0,0 +1,64 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+// Environment is a DigiKey API environment. Each environment has its own
+// API and token URLs, and its own client credentials.
+type Environment int
+
+// DigiKey API environments.
+const (
+	// Production is the live DigiKey API.
+	Production Environment = iota
+	// Sandbox is the DigiKey sandbox, which returns test data and does
+	// not place orders.
+	Sandbox
+)
+
+// String implements fmt.Stringer.
+func (e Environment) String() string {
+	if e == Sandbox {
+		return "Sandbox"
+	}
+	return "Production"
+}
+
+// BaseURL returns the API base URL of the environment.
+func (e Environment) BaseURL() string {
+	if e == Sandbox {
+		return sandboxURL
+	}
+	return apiURL
+}
+
+// TokenURL returns the OAuth2 token URL of the environment.
+func (e Environment) TokenURL() string {
+	if e == Sandbox {
+		return sandboxTokenURL
+	}
+	return accessTokenURL
+}
+
+// WithEnvironment sets the API and token URLs to those of the environment.
+// Options applied later, such as WithBaseURL, override them.
+func WithEnvironment(env Environment) ClientOption {
+	return func(client *Client) {
+		client.environment = env
+		client.baseURL = env.BaseURL()
+		client.accessTokenURL = env.TokenURL()
+	}
+}
+
+// NewSandboxClient creates a client of the DigiKey sandbox with the given
+// sandbox credentials.
+func NewSandboxClient(id, secret string, opts ...ClientOption) (*Client, error) {
+	return NewClient(id, secret, append([]ClientOption{WithEnvironment(Sandbox)}, opts...)...)
+}
+
+// Environment returns the environment the client was created for.
+func (c *Client) Environment() Environment {
+	return c.environment
+}
@@ -0,0 +1,188 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+// Demo is a small web application exercising the digikey package: keyword
+// search, part details with pricing and stock, and BOM pricing, all served
+// through a response cache.
+//
+// Credentials are read from the DIGIKEY_CLIENT_ID and DIGIKEY_CLIENT_SECRET
+// environment variables. Use -sandbox to run against the DigiKey sandbox, or
+// -base-url and -token-url to run against a fake server.
+//
+//	go run ./examples/demo -sandbox -addr localhost:8080
+package main
+
+import (
+	"context"
+	"embed"
+	"encoding/csv"
+	"errors"
+	"flag"
+	"html/template"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/apidepot/digikey"
+)
+
+//go:embed templates/*.html
+var templateFS embed.FS
+
+var templates = template.Must(template.ParseFS(templateFS, "templates/*.html"))
+
+type server struct {
+	client *digikey.Client
+}
+
+func main() {
+	addr := flag.String("addr", "localhost:8080", "address to listen on")
+	sandbox := flag.Bool("sandbox", false, "use the DigiKey sandbox")
+	baseURL := flag.String("base-url", "", "API base URL, e.g. of a fake server")
+	tokenURL := flag.String("token-url", "", "OAuth2 token URL, e.g. of a fake server")
+	flag.Parse()
+
+	opts := []digikey.ClientOption{
+		digikey.WithCache(digikey.NewMemoryCache(), 10*time.Minute),
+	}
+	if *sandbox {
+		opts = append(opts, digikey.WithDefaultSandbox())
+	}
+	if *baseURL != "" {
+		opts = append(opts, digikey.WithBaseURL(*baseURL))
+	}
+	if *tokenURL != "" {
+		opts = append(opts, digikey.WithTokenURL(*tokenURL))
+	}
+	client, err := digikey.NewClient(
+		os.Getenv("DIGIKEY_CLIENT_ID"),
+		os.Getenv("DIGIKEY_CLIENT_SECRET"),
+		opts...,
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	s := &server{client: client}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /{$}", s.search)
+	mux.HandleFunc("GET /part", s.part)
+	mux.HandleFunc("GET /bom", s.bom)
+	mux.HandleFunc("POST /bom", s.bom)
+
+	log.Printf("listening on http://%s", *addr)
+	log.Fatal(http.ListenAndServe(*addr, mux))
+}
+
+func (s *server) search(w http.ResponseWriter, r *http.Request) {
+	data := struct {
+		Query    string
+		Response *digikey.KeywordResponse
+		Error    error
+	}{Query: r.FormValue("q")}
+	if data.Query != "" {
+		req := digikey.NewSearchRequest(data.Query).Limit(25)
+		if r.FormValue("instock") != "" {
+			req.InStockOnly()
+		}
+		data.Response, data.Error = s.client.Products.KeywordSearch(r.Context(), req.Build())
+	}
+	render(w, "search.html", data)
+}
+
+func (s *server) part(w http.ResponseWriter, r *http.Request) {
+	data := struct {
+		Product *digikey.Product
+		Error   error
+	}{}
+	data.Product, data.Error = s.client.Products.ProductDetails(r.Context(), r.FormValue("pn"))
+	render(w, "part.html", data)
+}
+
+// bomLine is a priced line of an uploaded BOM.
+type bomLine struct {
+	PartNumber    string
+	Quantity      int
+	Product       *digikey.Product
+	Variation     *digikey.ProductVariation
+	UnitPrice     digikey.Money
+	ExtendedPrice digikey.Money
+	Error         error
+}
+
+func (s *server) bom(w http.ResponseWriter, r *http.Request) {
+	data := struct {
+		Lines []bomLine
+		Total digikey.Money
+		Error error
+	}{}
+	if r.Method == http.MethodPost {
+		var in io.Reader = strings.NewReader(r.FormValue("bom"))
+		if f, _, err := r.FormFile("file"); err == nil {
+			defer f.Close()
+			in = f
+		}
+		data.Lines, data.Error = s.priceBOM(r.Context(), in)
+		for i, l := range data.Lines {
+			total, err := data.Total.Add(l.ExtendedPrice)
+			if err != nil {
+				data.Lines[i].Error = err
+				continue
+			}
+			data.Total = total
+		}
+	}
+	render(w, "bom.html", data)
+}
+
+// priceBOM reads CSV lines of part number and quantity and prices each line
+// using the first packaging option with standard pricing.
+func (s *server) priceBOM(ctx context.Context, in io.Reader) ([]bomLine, error) {
+	cr := csv.NewReader(in)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+	records, err := cr.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	var lines []bomLine
+	for _, rec := range records {
+		if len(rec) < 2 {
+			continue
+		}
+		qty, err := strconv.Atoi(strings.TrimSpace(rec[1]))
+		if err != nil {
+			// Skip header rows and other non-numeric quantities.
+			continue
+		}
+		l := bomLine{PartNumber: strings.TrimSpace(rec[0]), Quantity: qty}
+		l.Product, l.Error = s.client.Products.ProductDetails(ctx, l.PartNumber)
+		if l.Error == nil {
+			l.Error = errors.New("no standard pricing")
+			for i, v := range l.Product.ProductVariations {
+				if len(v.StandardPricing) == 0 {
+					continue
+				}
+				l.Variation = &l.Product.ProductVariations[i]
+				l.UnitPrice, _ = digikey.UnitPriceAt(v.StandardPricing, qty)
+				l.ExtendedPrice, l.Error = digikey.ExtendedPrice(v.StandardPricing, qty, v.MinimumOrderQuantity, digikey.Money{})
+				break
+			}
+		}
+		lines = append(lines, l)
+	}
+	return lines, nil
+}
+
+func render(w http.ResponseWriter, name string, data any) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := templates.ExecuteTemplate(w, name, data); err != nil {
+		log.Printf("error rendering %s: %v", name, err)
+	}
+}
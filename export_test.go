@@ -0,0 +1,32 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import "time"
+
+// This file exports internals to the tests of package digikey_test.
+
+// SetTokenExpiry makes the current access token expire after d.
+func (c *Client) SetTokenExpiry(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.tokenExpiresAt = time.Now().Add(d)
+}
+
+// EndTokenBackoff lets the next request retry a failed token request.
+func (c *Client) EndTokenBackoff() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.tokenErr != nil {
+		c.tokenErr.RetryAt = time.Now()
+	}
+}
+
+// TokenBackoffDelay returns the delay before retrying after failures
+// consecutive failed token requests.
+func (c *Client) TokenBackoffDelay(failures int) time.Duration {
+	return c.tokenBackoffDelay(failures)
+}
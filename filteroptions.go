@@ -0,0 +1,100 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import "strings"
+
+// FilterOptions are the filters available to narrow a keyword search, with
+// the number of matching products for each, as returned with the search.
+// Pass a selected option back through the SearchRequest methods, e.g.
+//
+//	m := resp.FilterOptions.Manufacturers[0]
+//	req.ManufacturerID(m.ID)
+type FilterOptions struct {
+	Manufacturers      []BaseFilter             `json:"Manufacturers"`
+	Packaging          []BaseFilter             `json:"Packaging"`
+	Status             []BaseFilter             `json:"Status"`
+	Series             []BaseFilter             `json:"Series"`
+	ParametricFilters  []ParametricFilterOption `json:"ParametricFilters"`
+	TopCategories      []TopCategory            `json:"TopCategories"`
+	MarketPlaceFilters []string                 `json:"MarketPlaceFilters"`
+}
+
+// BaseFilter is an available filter value, such as a manufacturer, and the
+// number of matching products that have it.
+type BaseFilter struct {
+	ID           int    `json:"Id"`
+	Value        string `json:"Value"`
+	ProductCount int    `json:"ProductCount"`
+}
+
+// ParametricFilterOption is a parameter of the products of a category and
+// its available values.
+type ParametricFilterOption struct {
+	Category      BaseFilter    `json:"Category"`
+	ParameterType string        `json:"ParameterType"`
+	ParameterID   int           `json:"ParameterId"`
+	ParameterName string        `json:"ParameterName"`
+	FilterValues  []FilterValue `json:"FilterValues"`
+}
+
+// FilterValue is an available value of a parameter and the number of
+// matching products that have it.
+type FilterValue struct {
+	ProductCount    int    `json:"ProductCount"`
+	ValueID         string `json:"ValueId"`
+	ValueName       string `json:"ValueName"`
+	RangeFilterType string `json:"RangeFilterType"`
+}
+
+// TopCategory is a category with many matching products, and its root.
+type TopCategory struct {
+	RootCategory TopCategoryNode `json:"RootCategory"`
+	Category     TopCategoryNode `json:"Category"`
+	Score        float64         `json:"Score"`
+	ImageURL     string          `json:"ImageUrl"`
+}
+
+// TopCategoryNode identifies a category of a TopCategory.
+type TopCategoryNode struct {
+	ID           int    `json:"Id"`
+	Name         string `json:"Name"`
+	ProductCount int    `json:"ProductCount"`
+}
+
+// Parameter returns the parametric filter with the given name, compared
+// case-insensitively, or false if there is none.
+func (o *FilterOptions) Parameter(name string) (*ParametricFilterOption, bool) {
+	for i, p := range o.ParametricFilters {
+		if strings.EqualFold(p.ParameterName, name) {
+			return &o.ParametricFilters[i], true
+		}
+	}
+	return nil, false
+}
+
+// Value returns the available value with the given name, compared
+// case-insensitively, or false if there is none.
+func (p *ParametricFilterOption) Value(name string) (FilterValue, bool) {
+	for _, v := range p.FilterValues {
+		if strings.EqualFold(v.ValueName, name) {
+			return v, true
+		}
+	}
+	return FilterValue{}, false
+}
+
+// ParameterOption limits the results to products whose parameter, as
+// returned in the filter options of an earlier search, has one of the
+// given values. Like Parameter, it replaces the category of parametric
+// filters from earlier calls.
+func (r *SearchRequest) ParameterOption(p ParametricFilterOption, values ...FilterValue) *SearchRequest {
+	ids := make([]string, len(values))
+	for i, v := range values {
+		ids[i] = v.ValueID
+	}
+	return r.Parameter(p.Category.ID, p.ParameterID, ids...)
+}
@@ -0,0 +1,174 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrUnknownCurrency is returned when a rate source has no rate for a
+// currency.
+var ErrUnknownCurrency = errors.New("unknown currency")
+
+// RateSource provides exchange rates, e.g. from a bank or FX service.
+type RateSource interface {
+	// Rate returns the number of units of the currency to that one unit
+	// of the currency from buys. Currencies are ISO 4217 codes.
+	Rate(ctx context.Context, from, to string) (float64, error)
+}
+
+// StaticRates is a RateSource with fixed rates, given as the value of one
+// unit of a common base currency in each currency, e.g.
+//
+//	StaticRates{"USD": 1, "EUR": 0.92, "GBP": 0.79}
+type StaticRates map[string]float64
+
+// Rate implements RateSource.
+func (r StaticRates) Rate(_ context.Context, from, to string) (float64, error) {
+	f, ok := r[strings.ToUpper(from)]
+	if !ok || f <= 0 {
+		return 0, fmt.Errorf("%w: %s", ErrUnknownCurrency, from)
+	}
+	t, ok := r[strings.ToUpper(to)]
+	if !ok || t <= 0 {
+		return 0, fmt.Errorf("%w: %s", ErrUnknownCurrency, to)
+	}
+	return t / f, nil
+}
+
+// Converter converts prices into a target currency using the rates of a
+// RateSource, caching each rate for a time so conversions of large BOMs
+// don't query the source once per price.
+type Converter struct {
+	source RateSource
+	target string
+	ttl    time.Duration
+
+	mu    sync.Mutex
+	rates map[string]cachedRate
+}
+
+type cachedRate struct {
+	rate      float64
+	expiresAt time.Time
+}
+
+// NewConverter creates a converter into the target currency. Rates are
+// cached for ttl, or not at all if ttl is zero.
+func NewConverter(source RateSource, target string, ttl time.Duration) *Converter {
+	return &Converter{
+		source: source,
+		target: strings.ToUpper(target),
+		ttl:    ttl,
+		rates:  make(map[string]cachedRate),
+	}
+}
+
+// Target returns the currency the converter converts into.
+func (c *Converter) Target() string {
+	return c.target
+}
+
+// Rate returns the rate from the currency into the target currency.
+func (c *Converter) Rate(ctx context.Context, from string) (float64, error) {
+	from = strings.ToUpper(from)
+	if from == c.target {
+		return 1, nil
+	}
+	c.mu.Lock()
+	cached, ok := c.rates[from]
+	c.mu.Unlock()
+	if ok && time.Now().Before(cached.expiresAt) {
+		return cached.rate, nil
+	}
+	rate, err := c.source.Rate(ctx, from, c.target)
+	if err != nil {
+		return 0, fmt.Errorf("error getting %s/%s rate: %w", from, c.target, err)
+	}
+	if c.ttl > 0 {
+		c.mu.Lock()
+		c.rates[from] = cachedRate{rate: rate, expiresAt: time.Now().Add(c.ttl)}
+		c.mu.Unlock()
+	}
+	return rate, nil
+}
+
+// Convert converts the amount into the target currency. Amounts without a
+// currency are assumed to be in DefaultCurrency.
+func (c *Converter) Convert(ctx context.Context, m Money) (Money, error) {
+	from := m.Currency
+	if from == "" {
+		from = DefaultCurrency
+	}
+	rate, err := c.Rate(ctx, from)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: m.Amount * rate, Currency: c.target}, nil
+}
+
+// ConvertPriceBreaks returns a copy of the price breaks in the target
+// currency.
+func (c *Converter) ConvertPriceBreaks(ctx context.Context, breaks []PriceBreak) ([]PriceBreak, error) {
+	if breaks == nil {
+		return nil, nil
+	}
+	converted := make([]PriceBreak, len(breaks))
+	for i, b := range breaks {
+		unit, err := c.Convert(ctx, b.UnitPrice)
+		if err != nil {
+			return nil, err
+		}
+		total, err := c.Convert(ctx, b.TotalPrice)
+		if err != nil {
+			return nil, err
+		}
+		converted[i] = PriceBreak{BreakQuantity: b.BreakQuantity, UnitPrice: unit, TotalPrice: total}
+	}
+	return converted, nil
+}
+
+// ConvertProduct converts the prices of the product into the target
+// currency in place.
+func (c *Converter) ConvertProduct(ctx context.Context, p *Product) error {
+	var err error
+	if p.UnitPrice, err = c.Convert(ctx, p.UnitPrice); err != nil {
+		return err
+	}
+	for i := range p.ProductVariations {
+		v := &p.ProductVariations[i]
+		if v.StandardPricing, err = c.ConvertPriceBreaks(ctx, v.StandardPricing); err != nil {
+			return err
+		}
+		if v.MyPricing, err = c.ConvertPriceBreaks(ctx, v.MyPricing); err != nil {
+			return err
+		}
+		if v.DigiReelFee, err = c.Convert(ctx, v.DigiReelFee); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ConvertKeywordResponse converts the prices of the products of the
+// response into the target currency in place, and sets its locale's
+// currency to the target.
+func (c *Converter) ConvertKeywordResponse(ctx context.Context, resp *KeywordResponse) error {
+	for _, products := range [][]Product{resp.Products, resp.ExactMatches} {
+		for i := range products {
+			if err := c.ConvertProduct(ctx, &products[i]); err != nil {
+				return err
+			}
+		}
+	}
+	resp.SearchLocaleUsed.Currency = c.target
+	return nil
+}
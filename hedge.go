@@ -0,0 +1,140 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+)
+
+const (
+	// hedgeSamples is the number of recent latencies the hedging delay is
+	// computed from.
+	hedgeSamples = 100
+	// minHedgeSamples is the number of latencies needed before the delay
+	// is computed from them rather than the initial delay.
+	minHedgeSamples = 10
+)
+
+// hedger tracks recent response latencies to decide when to hedge.
+type hedger struct {
+	percentile float64
+	initial    time.Duration
+
+	mu      sync.Mutex
+	samples []time.Duration
+	next    int
+}
+
+// WithHedging enables hedged requests for GET requests, which are
+// idempotent: if no response has arrived by the given percentile of recent
+// response latencies, e.g. 95, a second attempt is sent and the first
+// successful response of the two is used. Until enough latencies have been
+// observed, the second attempt is sent after the initial delay.
+//
+// Hedging trades requests for latency, so it suits interactive lookups such
+// as part search UIs rather than bulk jobs. Both attempts count against the
+// rate limit and quota.
+func WithHedging(percentile float64, initialDelay time.Duration) ClientOption {
+	return func(client *Client) {
+		client.hedging = &hedger{
+			percentile: min(max(percentile, 0), 100),
+			initial:    initialDelay,
+		}
+	}
+}
+
+// delay returns how long to wait for a response before hedging.
+func (h *hedger) delay() time.Duration {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if len(h.samples) < minHedgeSamples {
+		return h.initial
+	}
+	sorted := slices.Clone(h.samples)
+	slices.Sort(sorted)
+	i := int(math.Ceil(h.percentile/100*float64(len(sorted)))) - 1
+	return sorted[min(max(i, 0), len(sorted)-1)]
+}
+
+// observe records the latency of a successful response.
+func (h *hedger) observe(d time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if len(h.samples) < hedgeSamples {
+		h.samples = append(h.samples, d)
+		return
+	}
+	h.samples[h.next] = d
+	h.next = (h.next + 1) % hedgeSamples
+}
+
+// fetchResult is the outcome of one attempt of a hedged request.
+type fetchResult struct {
+	resp *http.Response
+	body []byte
+	err  error
+}
+
+// ok reports whether the attempt succeeded, so that the hedged request need
+// not wait for the other.
+func (r fetchResult) ok() bool {
+	return r.err == nil && r.resp.StatusCode < 500
+}
+
+// hedgedFetch sends the request and, if it has not completed within the
+// hedging delay, a second attempt, returning the first successful result,
+// or the first failure if both attempts fail. The slower attempt is
+// cancelled.
+func (c *Client) hedgedFetch(ctx context.Context, req *http.Request, o *requestOptions) (*http.Response, []byte, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	// Clone the hedge before the first attempt starts changing the request.
+	hedge := req.Clone(ctx)
+
+	results := make(chan fetchResult, 2)
+	launch := func(req *http.Request) {
+		go func() {
+			start := time.Now()
+			var r fetchResult
+			r.resp, r.body, r.err = c.fetch(ctx, req, o)
+			if r.ok() {
+				c.hedging.observe(time.Since(start))
+			}
+			results <- r
+		}()
+	}
+	launch(req)
+	inflight := 1
+	timer := time.NewTimer(c.hedging.delay())
+	defer timer.Stop()
+
+	var failed *fetchResult
+	for {
+		select {
+		case <-timer.C:
+			if failed == nil {
+				launch(hedge)
+				inflight++
+			}
+		case r := <-results:
+			inflight--
+			if r.ok() {
+				return r.resp, r.body, r.err
+			}
+			if failed == nil {
+				failed = &r
+			}
+			if inflight == 0 {
+				return failed.resp, failed.body, failed.err
+			}
+		}
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestHedgedGet(t *testing.T) {
+	var calls atomic.Int32
+	cancelled := make(chan struct{}, 1)
+	h := func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			select {
+			case <-r.Context().Done():
+				cancelled <- struct{}{}
+			case <-time.After(5 * time.Second):
+			}
+			return
+		}
+		w.Write([]byte("hedge"))
+	}
+	c := newTestClient(t, h, WithHedging(95, 10*time.Millisecond))
+	u, _ := c.url("products/v4/search/manufacturers", nil)
+	body, err := c.getBytes(context.Background(), u.String())
+	if err != nil || string(body) != "hedge" || calls.Load() != 2 {
+		t.Fatalf("hedged GET = %q, %v after %d requests, want the hedge's response after 2", body, err, calls.Load())
+	}
+	select {
+	case <-cancelled:
+	case <-time.After(time.Second):
+		t.Error("the slower attempt was not cancelled")
+	}
+}
+
+func TestHedgingOnlyGets(t *testing.T) {
+	var calls atomic.Int32
+	h := func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		time.Sleep(50 * time.Millisecond)
+		w.Write([]byte("{}"))
+	}
+	c := newTestClient(t, h, WithHedging(95, time.Millisecond))
+	var v map[string]any
+	if err := c.postJSON(context.Background(), "products/v4/search/keyword", map[string]string{"Keywords": "lm358"}, &v); err != nil {
+		t.Fatal(err)
+	}
+	if calls.Load() != 1 {
+		t.Errorf("POST sent %d times, want once, unhedged", calls.Load())
+	}
+}
+
+// trackedBody is a response body recording whether it was closed.
+type trackedBody struct {
+	io.Reader
+	closed atomic.Bool
+}
+
+func (b *trackedBody) Close() error {
+	b.closed.Store(true)
+	return nil
+}
+
+// roundTripFunc is an http.RoundTripper calling the function.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
+
+func TestHedgeClosesLoser(t *testing.T) {
+	var calls atomic.Int32
+	bodies := make(chan *trackedBody, 2)
+	rt := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		n := calls.Add(1)
+		if n == 1 {
+			// The first attempt answers late, ignoring cancellation.
+			time.Sleep(50 * time.Millisecond)
+		}
+		b := &trackedBody{Reader: strings.NewReader("attempt")}
+		bodies <- b
+		return &http.Response{StatusCode: http.StatusOK, Header: make(http.Header), Body: b, Request: req}, nil
+	})
+	c := newTestClient(t, nil, WithHedging(95, 10*time.Millisecond), WithHTTPClient(&http.Client{Transport: rt}))
+	u, _ := c.url("products/v4/search/manufacturers", nil)
+	if _, err := c.getBytes(context.Background(), u.String()); err != nil {
+		t.Fatal(err)
+	}
+	for range 2 {
+		select {
+		case b := <-bodies:
+			waitFor(t, "the response body to be closed", b.closed.Load)
+		case <-time.After(time.Second):
+			t.Fatal("the slower attempt did not complete")
+		}
+	}
+}
+
+func TestHedgerDelay(t *testing.T) {
+	h := &hedger{percentile: 95, initial: time.Second}
+	for i := range minHedgeSamples - 1 {
+		h.observe(time.Duration(i+1) * time.Millisecond)
+	}
+	if d := h.delay(); d != time.Second {
+		t.Errorf("delay() with too few samples = %s, want the initial %s", d, time.Second)
+	}
+	for i := range 2 * hedgeSamples {
+		h.observe(time.Duration(i%hedgeSamples+1) * time.Millisecond)
+	}
+	if d := h.delay(); d != 95*time.Millisecond {
+		t.Errorf("delay() = %s, want the 95th percentile, 95ms", d)
+	}
+}
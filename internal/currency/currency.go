@@ -0,0 +1,15 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+// Package currency links the packages of this module that decode stored
+// API responses, such as cache/sqlite, to the currency filling the client
+// applies to responses. The digikey package sets Fill when it is
+// initialized.
+package currency
+
+// Fill sets the currency of every digikey.Money in v that has none, taking
+// it from the nearest enclosing locale or Currency field, or else using
+// currency.
+var Fill func(v any, currency string)
@@ -0,0 +1,19 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+// Package testhooks links the digikeytest package, and the tests of the
+// module's other packages, to client internals that are not part of the
+// digikey package's API. The digikey package sets the
+// hooks when it is initialized.
+package testhooks
+
+import "time"
+
+// ExpireToken marks the access token of a *digikey.Client as expired.
+var ExpireToken func(client any)
+
+// SetQuotaClock makes a *digikey.QuotaTracker read the time from now, so
+// that tests of packages pacing requests by the quota can use a fake clock.
+var SetQuotaClock func(tracker any, now func() time.Time)
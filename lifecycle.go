@@ -0,0 +1,135 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import (
+	"context"
+	"strings"
+	"sync"
+)
+
+// Lifecycle is the lifecycle stage of a product.
+type Lifecycle int
+
+// Lifecycle stages, in order of increasing risk.
+const (
+	LifecycleUnknown Lifecycle = iota
+	LifecycleActive
+	LifecyclePreliminary
+	LifecycleNRND
+	LifecycleLastTimeBuy
+	LifecycleDiscontinued
+	LifecycleObsolete
+)
+
+var lifecycleNames = map[Lifecycle]string{
+	LifecycleUnknown:      "Unknown",
+	LifecycleActive:       "Active",
+	LifecyclePreliminary:  "Preliminary",
+	LifecycleNRND:         "Not For New Designs",
+	LifecycleLastTimeBuy:  "Last Time Buy",
+	LifecycleDiscontinued: "Discontinued at DigiKey",
+	LifecycleObsolete:     "Obsolete",
+}
+
+// ParseLifecycle parses a product status as returned by DigiKey, e.g.
+// "Not For New Designs".
+func ParseLifecycle(status string) Lifecycle {
+	s := strings.ToLower(strings.TrimSpace(status))
+	switch {
+	case s == "active":
+		return LifecycleActive
+	case strings.Contains(s, "preliminary"):
+		return LifecyclePreliminary
+	case strings.Contains(s, "not for new design") || s == "nrnd":
+		return LifecycleNRND
+	case strings.Contains(s, "last time buy"):
+		return LifecycleLastTimeBuy
+	case strings.Contains(s, "discontinued"):
+		return LifecycleDiscontinued
+	case strings.Contains(s, "obsolete"):
+		return LifecycleObsolete
+	default:
+		return LifecycleUnknown
+	}
+}
+
+// String implements fmt.Stringer.
+func (l Lifecycle) String() string {
+	if name, ok := lifecycleNames[l]; ok {
+		return name
+	}
+	return lifecycleNames[LifecycleUnknown]
+}
+
+// Risky reports whether the lifecycle stage threatens future supply: not
+// recommended for new designs, last time buy, discontinued, or obsolete.
+func (l Lifecycle) Risky() bool {
+	return l >= LifecycleNRND
+}
+
+// Lifecycle returns the parsed lifecycle stage of the status.
+func (s ProductStatus) Lifecycle() Lifecycle {
+	return ParseLifecycle(s.Status)
+}
+
+// Lifecycle returns the lifecycle stage of the product, taking its
+// discontinued and end of life flags into account when its status is less
+// severe.
+func (p Product) Lifecycle() Lifecycle {
+	l := p.ProductStatus.Lifecycle()
+	if p.EndOfLife && l < LifecycleObsolete {
+		l = LifecycleObsolete
+	}
+	if p.Discontinued && l < LifecycleDiscontinued {
+		l = LifecycleDiscontinued
+	}
+	return l
+}
+
+// LifecycleAuditResult is the lifecycle audit of a single part number. Err
+// is set if the part could not be looked up, in which case Product is nil.
+type LifecycleAuditResult struct {
+	PartNumber string
+	Product    *Product
+	Lifecycle  Lifecycle
+	Risky      bool
+	Err        error
+}
+
+// LifecycleAudit looks up each part number and reports its lifecycle stage,
+// flagging risky parts, with as many lookups in flight as the client's
+// concurrency. Results are in the order of the part numbers. Failed lookups
+// are reported in the result for the part rather than stopping the audit;
+// only cancelling the context does so, in which case the results are
+// returned with the context's error, which those not looked up also carry.
+func (s *ProductsService) LifecycleAudit(ctx context.Context, partNumbers []string, opts ...RequestOption) ([]LifecycleAuditResult, error) {
+	results := make([]LifecycleAuditResult, len(partNumbers))
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, s.client.concurrency)
+	for i, pn := range partNumbers {
+		r := &results[i]
+		r.PartNumber = pn
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			r.Err = ctx.Err()
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			r.Product, r.Err = s.ProductDetails(ctx, pn, opts...)
+			if r.Err == nil {
+				r.Lifecycle = r.Product.Lifecycle()
+				r.Risky = r.Lifecycle.Risky()
+			}
+		}()
+	}
+	wg.Wait()
+	return results, ctx.Err()
+}
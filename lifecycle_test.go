@@ -0,0 +1,50 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/apidepot/digikey"
+	"github.com/apidepot/digikey/digikeytest"
+)
+
+func TestLifecycleAudit(t *testing.T) {
+	srv := digikeytest.NewServer("id", "secret")
+	defer srv.Close()
+	c, err := srv.NewClient("id", "secret", digikey.WithConcurrency(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Concurrency() != 2 {
+		t.Errorf("Concurrency() = %d, want 2", c.Concurrency())
+	}
+
+	pns := []string{"LM358DR", "NO-SUCH-PART", "RC0603FR-0710KL", "LM358DR"}
+	results, err := c.Products.LifecycleAudit(context.Background(), pns)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, r := range results {
+		if r.PartNumber != pns[i] {
+			t.Errorf("result %d is for %s, want %s", i, r.PartNumber, pns[i])
+		}
+		if (r.Err != nil) != (pns[i] == "NO-SUCH-PART") {
+			t.Errorf("result for %s: error %v", pns[i], r.Err)
+		}
+	}
+	if results[0].Lifecycle != digikey.LifecycleActive || results[0].Risky {
+		t.Errorf("LM358DR lifecycle = %v, risky %v", results[0].Lifecycle, results[0].Risky)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	results, err = c.Products.LifecycleAudit(ctx, pns)
+	if err != context.Canceled || len(results) != len(pns) || results[3].Err == nil {
+		t.Errorf("LifecycleAudit() with a cancelled context = %d results, %v", len(results), err)
+	}
+}
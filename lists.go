@@ -0,0 +1,129 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+const listsPath = "mylists/v1/lists"
+
+// ListsService provides access to the MyLists API, which manages the parts
+// lists of a myDigiKey account. The client must use a token from the
+// three-legged OAuth2 flow; see WithAccessToken. Lists are read from the
+// API every time, bypassing the client's cache.
+type ListsService struct {
+	client *Client
+}
+
+// List is a myDigiKey parts list.
+type List struct {
+	ID           string   `json:"Id"`
+	ListName     string   `json:"ListName"`
+	DateCreated  Time     `json:"DateCreated"`
+	LastModified Time     `json:"LastModified"`
+	TotalParts   int      `json:"TotalParts"`
+	Tags         []string `json:"Tags"`
+}
+
+// CreateListRequest is the request body for creating a list.
+type CreateListRequest struct {
+	ListName string   `json:"ListName"`
+	Tags     []string `json:"Tags,omitempty"`
+}
+
+// List returns the lists of the authenticated user.
+func (s *ListsService) List(ctx context.Context, opts ...RequestOption) ([]List, error) {
+	var lists []List
+	if err := s.client.getCustomerJSON(ctx, listsPath, nil, &lists, opts...); err != nil {
+		return nil, err
+	}
+	return lists, nil
+}
+
+// Get returns the list with the given ID.
+func (s *ListsService) Get(ctx context.Context, listID string, opts ...RequestOption) (*List, error) {
+	list := &List{}
+	if err := s.client.getCustomerJSON(ctx, listPath(listID), nil, list, opts...); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// Create creates a list and returns its ID.
+func (s *ListsService) Create(ctx context.Context, req CreateListRequest, opts ...RequestOption) (string, error) {
+	var id string
+	if err := s.client.sendJSON(ctx, "POST", listsPath, req, &id, opts...); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// Rename changes the name of the list with the given ID.
+func (s *ListsService) Rename(ctx context.Context, listID, name string, opts ...RequestOption) error {
+	endpoint := listPath(listID) + "/listName/" + url.PathEscape(name)
+	return s.client.sendJSON(ctx, "PUT", endpoint, nil, nil, opts...)
+}
+
+// Delete deletes the list with the given ID.
+func (s *ListsService) Delete(ctx context.Context, listID string, opts ...RequestOption) error {
+	return s.client.sendJSON(ctx, "DELETE", listPath(listID), nil, nil, opts...)
+}
+
+// ListPart is a part on a list. PartID is assigned by DigiKey and is ignored
+// when adding parts.
+type ListPart struct {
+	PartID              string         `json:"PartId,omitempty"`
+	RequestedPartNumber string         `json:"RequestedPartNumber"`
+	ManufacturerName    string         `json:"ManufacturerName,omitempty"`
+	Quantities          []ListQuantity `json:"Quantities,omitempty"`
+	CustomerReference   string         `json:"CustomerReference,omitempty"`
+	ReferenceDesignator string         `json:"ReferenceDesignator,omitempty"`
+	Notes               string         `json:"Notes,omitempty"`
+}
+
+// ListQuantity is a quantity of a list part, such as the quantity for one
+// build.
+type ListQuantity struct {
+	Quantity int `json:"Quantity"`
+}
+
+// Parts returns the parts on the list with the given ID.
+func (s *ListsService) Parts(ctx context.Context, listID string, opts ...RequestOption) ([]ListPart, error) {
+	var parts []ListPart
+	if err := s.client.getCustomerJSON(ctx, listPath(listID)+"/parts", nil, &parts, opts...); err != nil {
+		return nil, err
+	}
+	return parts, nil
+}
+
+// AddParts adds the parts to the list with the given ID.
+func (s *ListsService) AddParts(ctx context.Context, listID string, parts []ListPart, opts ...RequestOption) error {
+	add := make([]ListPart, len(parts))
+	for i, p := range parts {
+		p.PartID = ""
+		add[i] = p
+	}
+	return s.client.sendJSON(ctx, "POST", listPath(listID)+"/parts", add, nil, opts...)
+}
+
+// RemoveParts removes the parts with the given part IDs from the list with
+// the given ID. It stops at the first part that cannot be removed.
+func (s *ListsService) RemoveParts(ctx context.Context, listID string, partIDs []string, opts ...RequestOption) error {
+	for _, id := range partIDs {
+		endpoint := listPath(listID) + "/parts/" + url.PathEscape(id)
+		if err := s.client.sendJSON(ctx, "DELETE", endpoint, nil, nil, opts...); err != nil {
+			return fmt.Errorf("error removing part %s: %w", id, err)
+		}
+	}
+	return nil
+}
+
+func listPath(listID string) string {
+	return listsPath + "/" + url.PathEscape(listID)
+}
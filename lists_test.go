@@ -0,0 +1,76 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// listServer serves the parts of lists, keeping them in memory.
+type listServer struct {
+	mu    sync.Mutex
+	parts map[string][]ListPart
+}
+
+func (s *listServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	id, rest, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"+listsPath+"/"), "/")
+	switch {
+	case rest == "parts" && r.Method == http.MethodGet:
+		json.NewEncoder(w).Encode(s.parts[id])
+	case rest == "parts" && r.Method == http.MethodPost:
+		var add []ListPart
+		json.NewDecoder(r.Body).Decode(&add)
+		for _, p := range add {
+			p.PartID = p.RequestedPartNumber
+			s.parts[id] = append(s.parts[id], p)
+		}
+	case strings.HasPrefix(rest, "parts/") && r.Method == http.MethodDelete:
+		partID := strings.TrimPrefix(rest, "parts/")
+		for i, p := range s.parts[id] {
+			if p.PartID == partID {
+				s.parts[id] = append(s.parts[id][:i], s.parts[id][i+1:]...)
+				break
+			}
+		}
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func TestListsBypassCache(t *testing.T) {
+	ls := &listServer{parts: map[string][]ListPart{}}
+	c := newTestClient(t, ls.ServeHTTP, WithCache(NewMemoryCache(), time.Hour))
+	ctx := context.Background()
+
+	parts, err := c.Lists.Parts(ctx, "L1")
+	if err != nil || len(parts) != 0 {
+		t.Fatalf("Parts() = %v, %v, want an empty list", parts, err)
+	}
+	if err := c.Lists.AddParts(ctx, "L1", []ListPart{{RequestedPartNumber: "296-1395-5-ND"}}); err != nil {
+		t.Fatal(err)
+	}
+	parts, err = c.Lists.Parts(ctx, "L1")
+	if err != nil || len(parts) != 1 {
+		t.Fatalf("Parts() after AddParts = %v, %v, want the added part", parts, err)
+	}
+	if err := c.Lists.RemoveParts(ctx, "L1", []string{parts[0].PartID}); err != nil {
+		t.Fatal(err)
+	}
+	if parts, err := c.Lists.Parts(ctx, "L1"); err != nil || len(parts) != 0 {
+		t.Errorf("Parts() after RemoveParts = %v, %v, want an empty list", parts, err)
+	}
+	if stats := c.CacheStats(); stats.Hits != 0 || stats.Misses != 0 || stats.Stores != 0 {
+		t.Errorf("CacheStats() = %+v, want the cache untouched", stats)
+	}
+}
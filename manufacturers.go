@@ -0,0 +1,104 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrUnknownManufacturer is returned when a manufacturer name given to
+// SearchRequest.Manufacturer matches no DigiKey manufacturer.
+var ErrUnknownManufacturer = errors.New("unknown manufacturer")
+
+// manufacturerListTTL is how long the manufacturer list used to resolve
+// names is kept before it is requested again.
+const manufacturerListTTL = 24 * time.Hour
+
+// manufacturerList caches the manufacturer list of a ProductsService.
+type manufacturerList struct {
+	mu        sync.Mutex
+	list      []Manufacturer
+	fetchedAt time.Time
+}
+
+// manufacturersResponse is the response to a manufacturers request.
+type manufacturersResponse struct {
+	Manufacturers []Manufacturer `json:"Manufacturers"`
+}
+
+// Manufacturers returns every manufacturer known to DigiKey.
+func (s *ProductsService) Manufacturers(ctx context.Context, opts ...RequestOption) ([]Manufacturer, error) {
+	resp := manufacturersResponse{}
+	if err := s.client.getJSON(ctx, productsPath+"manufacturers", nil, &resp, opts...); err != nil {
+		return nil, err
+	}
+	return resp.Manufacturers, nil
+}
+
+// FindManufacturer returns the manufacturer with the given name, matched
+// case-insensitively and ignoring surrounding whitespace.
+func FindManufacturer(manufacturers []Manufacturer, name string) (Manufacturer, bool) {
+	name = strings.TrimSpace(name)
+	for _, m := range manufacturers {
+		if strings.EqualFold(strings.TrimSpace(m.Name), name) {
+			return m, true
+		}
+	}
+	return Manufacturer{}, false
+}
+
+// cachedManufacturers returns the manufacturer list, requesting it only if
+// it has not been requested within manufacturerListTTL.
+func (s *ProductsService) cachedManufacturers(ctx context.Context, opts []RequestOption) ([]Manufacturer, error) {
+	s.manufacturers.mu.Lock()
+	defer s.manufacturers.mu.Unlock()
+	if s.manufacturers.list != nil && time.Since(s.manufacturers.fetchedAt) < manufacturerListTTL {
+		return s.manufacturers.list, nil
+	}
+	list, err := s.Manufacturers(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	s.manufacturers.list, s.manufacturers.fetchedAt = list, time.Now()
+	return list, nil
+}
+
+// resolveManufacturers returns the request with the manufacturers named by
+// Manufacturer added to its manufacturer filter.
+func (s *ProductsService) resolveManufacturers(ctx context.Context, req KeywordRequest, opts []RequestOption) (KeywordRequest, error) {
+	if len(req.manufacturerNames) == 0 {
+		return req, nil
+	}
+	list, err := s.cachedManufacturers(ctx, opts)
+	if err != nil {
+		return req, fmt.Errorf("error resolving manufacturers: %w", err)
+	}
+	f := FilterOptionsRequest{}
+	if req.FilterOptionsRequest != nil {
+		f = *req.FilterOptionsRequest
+	}
+	f.ManufacturerFilter = cloneIDs(f.ManufacturerFilter)
+	for _, name := range req.manufacturerNames {
+		m, ok := FindManufacturer(list, name)
+		if !ok {
+			return req, fmt.Errorf("%w: %q", ErrUnknownManufacturer, name)
+		}
+		fid := FilterID{ID: strconv.Itoa(m.ID)}
+		if !slices.Contains(f.ManufacturerFilter, fid) {
+			f.ManufacturerFilter = append(f.ManufacturerFilter, fid)
+		}
+	}
+	req.FilterOptionsRequest = &f
+	req.manufacturerNames = nil
+	return req, nil
+}
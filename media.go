@@ -0,0 +1,67 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import (
+	"context"
+	"strings"
+)
+
+// MediaLink is a link to a photo, document, video, or model of a product.
+type MediaLink struct {
+	MediaType  string `json:"MediaType"`
+	Title      string `json:"Title"`
+	SmallPhoto string `json:"SmallPhoto"`
+	Thumbnail  string `json:"Thumbnail"`
+	URL        string `json:"Url"`
+}
+
+// Media contains the media links of a product.
+type Media struct {
+	Links []MediaLink `json:"MediaLinks"`
+}
+
+// Media returns the photos, datasheets, CAD models, and other media of the
+// product with the given DigiKey or manufacturer product number.
+func (s *ProductsService) Media(ctx context.Context, partNumber string, opts ...RequestOption) (*Media, error) {
+	resp := &Media{}
+	if err := s.client.getJSON(ctx, productPath(partNumber, "media"), nil, resp, opts...); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Photos returns the product photo links.
+func (m *Media) Photos() []MediaLink {
+	return m.ofType("photo")
+}
+
+// Datasheets returns the datasheet links.
+func (m *Media) Datasheets() []MediaLink {
+	return m.ofType("datasheet")
+}
+
+// CADModels returns the EDA symbol, footprint, and 3D model links.
+func (m *Media) CADModels() []MediaLink {
+	var links []MediaLink
+	for _, l := range m.Links {
+		t := strings.ToLower(l.MediaType)
+		if strings.Contains(t, "cad") || strings.Contains(t, "eda") || strings.Contains(t, "model") {
+			links = append(links, l)
+		}
+	}
+	return links
+}
+
+func (m *Media) ofType(substr string) []MediaLink {
+	var links []MediaLink
+	for _, l := range m.Links {
+		if strings.Contains(strings.ToLower(l.MediaType), substr) {
+			links = append(links, l)
+		}
+	}
+	return links
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// requestIDHeaders are the headers that may carry the ID DigiKey assigns to
+// a request, in order of preference.
+var requestIDHeaders = []string{"X-Request-Id", "X-Correlation-Id", "X-MS-Request-Id"}
+
+// ResponseMetadata describes the response to a request, for logging and
+// debugging.
+type ResponseMetadata struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// RequestID is the ID DigiKey assigned to the request, if the response
+	// reported one. Quote it when contacting DigiKey support.
+	RequestID string
+	// RateLimit and RateLimitRemaining are the daily request limit and the
+	// number of requests remaining, or -1 if the response did not report
+	// them.
+	RateLimit          int
+	RateLimitRemaining int
+	// Latency is the time from sending the request to reading the response
+	// body, excluding waiting for the rate limiter.
+	Latency time.Duration
+	// Cached reports whether the response was served from the client's
+	// cache, in which case only Cached and CacheKey are set.
+	Cached bool
+	// CacheKey is the key of the response in the client's cache, or empty
+	// if the response is not cached. Pass it to Client.Invalidate to drop
+	// the response from the cache.
+	CacheKey string
+	// Header contains the response headers.
+	Header http.Header
+}
+
+// WithResponseMetadata stores the metadata of the response in m when the
+// request completes, including when it fails with an API error, e.g.
+//
+//	var meta digikey.ResponseMetadata
+//	p, err := c.Products.ProductDetails(ctx, pn, digikey.WithResponseMetadata(&meta))
+//	log.Printf("request %s took %v", meta.RequestID, meta.Latency)
+func WithResponseMetadata(m *ResponseMetadata) RequestOption {
+	return func(o *requestOptions) {
+		o.metadata = m
+	}
+}
+
+// newResponseMetadata returns the metadata of the response.
+func newResponseMetadata(resp *http.Response, latency time.Duration) ResponseMetadata {
+	m := ResponseMetadata{
+		StatusCode:         resp.StatusCode,
+		RateLimit:          headerInt(resp.Header, "X-RateLimit-Limit"),
+		RateLimitRemaining: headerInt(resp.Header, "X-RateLimit-Remaining"),
+		Latency:            latency,
+		Header:             resp.Header.Clone(),
+	}
+	for _, h := range requestIDHeaders {
+		if id := resp.Header.Get(h); id != "" {
+			m.RequestID = id
+			break
+		}
+	}
+	return m
+}
+
+// headerInt returns the integer value of the header, or -1 if it is missing
+// or invalid.
+func headerInt(h http.Header, key string) int {
+	n, err := strconv.Atoi(h.Get(key))
+	if err != nil {
+		return -1
+	}
+	return n
+}
@@ -0,0 +1,342 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+// Package mirror downloads the datasheets and primary photos of DigiKey
+// products to a Store, such as a local directory, e.g. to assemble the
+// documentation kit of a design for an air-gapped site.
+//
+// Assets are stored under keys derived from their URLs, so an asset shared
+// by several parts, such as the datasheet of a product family, is
+// downloaded once. A manifest in the store records what each part mirrored;
+// running the mirror again skips the parts and assets already complete, so
+// an interrupted run resumes where it stopped.
+package mirror
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/apidepot/digikey"
+)
+
+// DefaultConcurrency is the number of parts mirrored at once, unless
+// changed with WithConcurrency.
+const DefaultConcurrency = 4
+
+// ManifestKey is the key of the manifest in the store.
+const ManifestKey = "manifest.json"
+
+// Asset is a mirrored file.
+type Asset struct {
+	URL  string `json:"url"`
+	Key  string `json:"key"`
+	Size int64  `json:"size,omitempty"`
+}
+
+// PartAssets are the assets mirrored for a part. Err is set if the part
+// could not be looked up or an asset could not be downloaded, in which
+// case the next run tries the part again.
+type PartAssets struct {
+	PartNumber           string    `json:"partNumber"`
+	DigiKeyProductNumber string    `json:"digiKeyProductNumber,omitempty"`
+	Datasheet            *Asset    `json:"datasheet,omitempty"`
+	Photo                *Asset    `json:"photo,omitempty"`
+	MirroredAt           time.Time `json:"mirroredAt"`
+	Err                  string    `json:"error,omitempty"`
+}
+
+// Manifest lists the mirrored assets of each part, keyed by part number.
+type Manifest struct {
+	Parts map[string]PartAssets `json:"parts"`
+}
+
+// Mirror downloads the assets of parts to a store. Product lookups go
+// through the client, so they are subject to its rate limiter, quota, and
+// cache; downloads use the client's HTTP settings but carry no credentials.
+type Mirror struct {
+	client      *digikey.Client
+	store       Store
+	concurrency int
+	datasheets  bool
+	photos      bool
+	requestOpts []digikey.RequestOption
+
+	mu       sync.Mutex
+	inflight map[string]*download
+}
+
+// download is an asset download shared by the parts that need it.
+type download struct {
+	done chan struct{}
+	size int64
+	err  error
+}
+
+// Option applies an option to a mirror.
+type Option func(*Mirror)
+
+// WithConcurrency sets the number of parts mirrored at once.
+func WithConcurrency(n int) Option {
+	return func(m *Mirror) {
+		m.concurrency = max(n, 1)
+	}
+}
+
+// WithoutDatasheets skips datasheets.
+func WithoutDatasheets() Option {
+	return func(m *Mirror) {
+		m.datasheets = false
+	}
+}
+
+// WithoutPhotos skips product photos.
+func WithoutPhotos() Option {
+	return func(m *Mirror) {
+		m.photos = false
+	}
+}
+
+// WithRequestOptions sets request options applied to every product lookup.
+func WithRequestOptions(opts ...digikey.RequestOption) Option {
+	return func(m *Mirror) {
+		m.requestOpts = append(m.requestOpts, opts...)
+	}
+}
+
+// New creates a mirror that looks up parts using the client and stores
+// their assets in the store.
+func New(c *digikey.Client, store Store, opts ...Option) *Mirror {
+	m := &Mirror{
+		client:      c,
+		store:       store,
+		concurrency: DefaultConcurrency,
+		datasheets:  true,
+		photos:      true,
+		inflight:    make(map[string]*download),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// Run mirrors the assets of the parts with the given DigiKey or
+// manufacturer product numbers and returns the updated manifest, which is
+// also saved to the store. Parts the manifest records as complete, with
+// their assets still in the store, are skipped. A failed part is recorded
+// in the manifest rather than stopping the run; only cancelling the
+// context or failing to read or save the manifest does so.
+func (m *Mirror) Run(ctx context.Context, partNumbers []string) (*Manifest, error) {
+	manifest, err := m.loadManifest(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, m.concurrency)
+	for _, pn := range partNumbers {
+		if prev, ok := manifest.Parts[pn]; ok && m.complete(ctx, prev) {
+			continue
+		}
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			assets := m.mirrorPart(ctx, pn)
+			mu.Lock()
+			manifest.Parts[pn] = assets
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	// Save what was mirrored even if the run was cancelled, so that the
+	// next run resumes from there.
+	if err := m.saveManifest(context.WithoutCancel(ctx), manifest); err != nil {
+		return manifest, err
+	}
+	return manifest, ctx.Err()
+}
+
+// complete reports whether the part was mirrored without error and its
+// assets are still in the store.
+func (m *Mirror) complete(ctx context.Context, a PartAssets) bool {
+	if a.Err != "" {
+		return false
+	}
+	for _, asset := range []*Asset{a.Datasheet, a.Photo} {
+		if asset == nil {
+			continue
+		}
+		if ok, err := m.store.Exists(ctx, asset.Key); err != nil || !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// mirrorPart looks up the part and downloads its assets.
+func (m *Mirror) mirrorPart(ctx context.Context, pn string) PartAssets {
+	a := PartAssets{PartNumber: pn, MirroredAt: time.Now()}
+	p, err := m.client.Products.ProductDetails(ctx, pn, m.requestOpts...)
+	if err != nil {
+		a.Err = err.Error()
+		return a
+	}
+	if len(p.ProductVariations) > 0 {
+		a.DigiKeyProductNumber = p.ProductVariations[0].DigiKeyProductNumber
+	}
+	var errs []error
+	if m.datasheets {
+		a.Datasheet, err = m.fetch(ctx, "datasheets", ".pdf", p.DatasheetURL)
+		errs = append(errs, err)
+	}
+	if m.photos {
+		a.Photo, err = m.fetch(ctx, "photos", ".jpg", p.PhotoURL)
+		errs = append(errs, err)
+	}
+	if err := errors.Join(errs...); err != nil {
+		a.Err = err.Error()
+	}
+	return a
+}
+
+// fetch downloads the asset at the URL into the directory of the store,
+// unless the store has it already or another part is downloading it. It
+// returns nil if the URL is empty.
+func (m *Mirror) fetch(ctx context.Context, dir, defaultExt, rawURL string) (*Asset, error) {
+	rawURL = strings.TrimSpace(rawURL)
+	if rawURL == "" {
+		return nil, nil
+	}
+	if strings.HasPrefix(rawURL, "//") {
+		rawURL = "https:" + rawURL
+	}
+	asset := &Asset{URL: rawURL, Key: assetKey(dir, defaultExt, rawURL)}
+
+	m.mu.Lock()
+	d, ok := m.inflight[asset.Key]
+	if !ok {
+		d = &download{done: make(chan struct{})}
+		m.inflight[asset.Key] = d
+	}
+	m.mu.Unlock()
+	if !ok {
+		d.size, d.err = m.download(ctx, asset)
+		close(d.done)
+		if d.err != nil {
+			// Let later runs try again.
+			m.mu.Lock()
+			delete(m.inflight, asset.Key)
+			m.mu.Unlock()
+		}
+	}
+	select {
+	case <-d.done:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+	asset.Size = d.size
+	return asset, nil
+}
+
+// download stores the asset unless the store has it already, returning its
+// size, or zero if it was already stored.
+func (m *Mirror) download(ctx context.Context, asset *Asset) (int64, error) {
+	if ok, err := m.store.Exists(ctx, asset.Key); err != nil {
+		return 0, err
+	} else if ok {
+		return 0, nil
+	}
+	pr, pw := io.Pipe()
+	var n int64
+	downloaded := make(chan error, 1)
+	go func() {
+		var err error
+		n, err = m.client.Download(ctx, asset.URL, pw)
+		pw.CloseWithError(err)
+		downloaded <- err
+	}()
+	err := m.store.Put(ctx, asset.Key, pr)
+	// Unblock the download if the store failed first.
+	pr.CloseWithError(err)
+	// Report a failed download as such rather than as a failed write.
+	if dlErr := <-downloaded; dlErr != nil {
+		return 0, dlErr
+	}
+	if err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// assetKey returns the key of the asset at the URL: a hash of the URL, so
+// that assets shared by several parts are stored once, with the extension
+// of the URL's path, or defaultExt if it has none.
+func assetKey(dir, defaultExt, rawURL string) string {
+	sum := sha256.Sum256([]byte(rawURL))
+	ext := defaultExt
+	if u, err := url.Parse(rawURL); err == nil {
+		if e := strings.ToLower(path.Ext(u.Path)); e != "" && len(e) <= 5 {
+			ext = e
+		}
+	}
+	return dir + "/" + hex.EncodeToString(sum[:8]) + ext
+}
+
+// loadManifest reads the manifest from the store, or returns an empty one
+// if the store has none.
+func (m *Mirror) loadManifest(ctx context.Context) (*Manifest, error) {
+	manifest := &Manifest{Parts: make(map[string]PartAssets)}
+	r, err := m.store.Get(ctx, ManifestKey)
+	if errors.Is(err, ErrNotExist) {
+		return manifest, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading manifest: %w", err)
+	}
+	defer r.Close()
+	if err := json.NewDecoder(r).Decode(manifest); err != nil {
+		return nil, fmt.Errorf("error reading manifest: %w", err)
+	}
+	if manifest.Parts == nil {
+		manifest.Parts = make(map[string]PartAssets)
+	}
+	return manifest, nil
+}
+
+// saveManifest writes the manifest to the store.
+func (m *Mirror) saveManifest(ctx context.Context, manifest *Manifest) error {
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error writing manifest: %w", err)
+	}
+	if err := m.store.Put(ctx, ManifestKey, bytes.NewReader(data)); err != nil {
+		return fmt.Errorf("error writing manifest: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,182 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package mirror
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/apidepot/digikey"
+	"github.com/apidepot/digikey/digikeytest"
+)
+
+// assetServer serves datasheets and photos, counting the requests for each
+// path.
+type assetServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	requests map[string]int
+	missing  map[string]bool
+	authed   bool
+}
+
+func newAssetServer(t *testing.T) *assetServer {
+	s := &assetServer{requests: make(map[string]int), missing: make(map[string]bool)}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.requests[r.URL.Path]++
+		missing := s.missing[r.URL.Path]
+		s.authed = s.authed || r.Header.Get("Authorization") != "" || r.Header.Get("X-Digikey-Client-Id") != ""
+		s.mu.Unlock()
+		if missing {
+			http.NotFound(w, r)
+			return
+		}
+		io.WriteString(w, "contents of "+r.URL.Path)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *assetServer) count(path string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests[path]
+}
+
+func (s *assetServer) setMissing(path string, missing bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.missing[path] = missing
+}
+
+func TestMirror(t *testing.T) {
+	assets := newAssetServer(t)
+	srv := digikeytest.NewServer("id", "secret",
+		digikey.Product{
+			ManufacturerProductNumber: "PART-A",
+			DatasheetURL:              assets.URL + "/family.PDF",
+			PhotoURL:                  assets.URL + "/photos/a.png",
+			ProductVariations:         []digikey.ProductVariation{{DigiKeyProductNumber: "A-ND"}},
+		},
+		digikey.Product{
+			ManufacturerProductNumber: "PART-B",
+			DatasheetURL:              assets.URL + "/family.PDF",
+			PhotoURL:                  assets.URL + "/photos/b",
+		},
+		digikey.Product{
+			ManufacturerProductNumber: "PART-C",
+			DatasheetURL:              assets.URL + "/c.pdf",
+		},
+	)
+	defer srv.Close()
+	c, err := srv.NewClient("id", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	m := New(c, NewDirStore(dir))
+	ctx := context.Background()
+	parts := []string{"PART-A", "PART-B", "PART-C", "NO-SUCH-PART"}
+
+	assets.setMissing("/c.pdf", true)
+	manifest, err := m.Run(ctx, parts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a, b := manifest.Parts["PART-A"], manifest.Parts["PART-B"]
+	if a.Err != "" || b.Err != "" || a.DigiKeyProductNumber != "A-ND" {
+		t.Fatalf("mirrored parts A and B: %+v, %+v", a, b)
+	}
+	// A datasheet shared by parts is downloaded and stored once.
+	if a.Datasheet.Key != b.Datasheet.Key || assets.count("/family.PDF") != 1 {
+		t.Errorf("shared datasheet stored as %s and %s after %d downloads, want once", a.Datasheet.Key, b.Datasheet.Key, assets.count("/family.PDF"))
+	}
+	for _, tt := range []struct {
+		asset *Asset
+		ext   string
+		path  string
+	}{
+		{a.Datasheet, ".pdf", "/family.PDF"},
+		{a.Photo, ".png", "/photos/a.png"},
+		{b.Photo, ".jpg", "/photos/b"},
+	} {
+		data, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(tt.asset.Key)))
+		if err != nil || string(data) != "contents of "+tt.path || filepath.Ext(tt.asset.Key) != tt.ext {
+			t.Errorf("asset %s stored as %s: %q, %v, want the contents of %s", tt.asset.URL, tt.asset.Key, data, err, tt.path)
+		}
+	}
+	if manifest.Parts["PART-C"].Err == "" || manifest.Parts["NO-SUCH-PART"].Err == "" {
+		t.Errorf("parts with a missing datasheet and unknown to DigiKey mirrored without error: %+v", manifest.Parts)
+	}
+	if assets.authed {
+		t.Error("asset downloads carried DigiKey credentials")
+	}
+
+	// The next run, resuming from the saved manifest, retries only the
+	// failed parts and parts whose assets were removed from the store.
+	assets.setMissing("/c.pdf", false)
+	if err := os.Remove(filepath.Join(dir, filepath.FromSlash(b.Photo.Key))); err != nil {
+		t.Fatal(err)
+	}
+	n := len(srv.Requests())
+	manifest, err = New(c, NewDirStore(dir)).Run(ctx, parts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := len(srv.Requests()) - n; got != 3 {
+		t.Errorf("second run looked up %d parts, want 3", got)
+	}
+	if assets.count("/family.PDF") != 1 || assets.count("/photos/a.png") != 1 || assets.count("/photos/b") != 2 || assets.count("/c.pdf") != 2 {
+		t.Errorf("second run downloads: %v, want only b's photo and c's datasheet again", assets.requests)
+	}
+	if c := manifest.Parts["PART-C"]; c.Err != "" || c.Datasheet == nil || c.Photo != nil {
+		t.Errorf("part C after the second run: %+v, want its datasheet", c)
+	}
+}
+
+func TestDirStore(t *testing.T) {
+	s := NewDirStore(filepath.Join(t.TempDir(), "mirror"))
+	ctx := context.Background()
+	if ok, err := s.Exists(ctx, "photos/x.jpg"); ok || err != nil {
+		t.Errorf("Exists() in an empty store = %t, %v", ok, err)
+	}
+	if _, err := s.Get(ctx, "photos/x.jpg"); !errors.Is(err, ErrNotExist) {
+		t.Errorf("Get() in an empty store = %v, want ErrNotExist", err)
+	}
+
+	// A failed Put leaves no object behind.
+	errRead := errors.New("read failed")
+	if err := s.Put(ctx, "photos/x.jpg", io.MultiReader(io.LimitReader(zeros{}, 10), errReader{errRead})); !errors.Is(err, errRead) {
+		t.Errorf("Put() of a failing reader = %v, want its error", err)
+	}
+	if ok, _ := s.Exists(ctx, "photos/x.jpg"); ok {
+		t.Error("failed Put() left an object behind")
+	}
+	entries, _ := os.ReadDir(filepath.Join(s.dir, "photos"))
+	if len(entries) != 0 {
+		t.Errorf("failed Put() left files %v", entries)
+	}
+}
+
+type zeros struct{}
+
+func (zeros) Read(p []byte) (int, error) {
+	clear(p)
+	return len(p), nil
+}
+
+type errReader struct{ err error }
+
+func (r errReader) Read([]byte) (int, error) { return 0, r.err }
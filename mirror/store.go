@@ -0,0 +1,88 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package mirror
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// ErrNotExist is returned by a Store for a key it has no object for.
+var ErrNotExist = fs.ErrNotExist
+
+// Store holds mirrored assets by key, such as a local directory or an
+// object store bucket. Keys are slash-separated paths, e.g.
+// "datasheets/3f2a9c1e.pdf". Put must not make a partly written object
+// visible, so that an interrupted mirror resumes cleanly.
+type Store interface {
+	// Exists reports whether the store has an object for the key.
+	Exists(ctx context.Context, key string) (bool, error)
+	// Get opens the object for the key, returning an error wrapping
+	// ErrNotExist if there is none.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Put stores the data read from r under the key, replacing any
+	// existing object.
+	Put(ctx context.Context, key string, r io.Reader) error
+}
+
+// DirStore is a Store keeping objects as files under a directory.
+type DirStore struct {
+	dir string
+}
+
+// NewDirStore returns a store keeping objects under dir, which is created
+// when the first object is stored.
+func NewDirStore(dir string) *DirStore {
+	return &DirStore{dir: dir}
+}
+
+// Exists implements Store.
+func (s *DirStore) Exists(_ context.Context, key string) (bool, error) {
+	_, err := os.Stat(s.path(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// Get implements Store.
+func (s *DirStore) Get(_ context.Context, key string) (io.ReadCloser, error) {
+	return os.Open(s.path(key))
+}
+
+// Put implements Store. The data is written to a temporary file that is
+// renamed into place once complete.
+func (s *DirStore) Put(_ context.Context, key string, r io.Reader) error {
+	path := s.path(key)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("error creating directory for %s: %w", key, err)
+	}
+	f, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("error creating %s: %w", key, err)
+	}
+	defer os.Remove(f.Name())
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return fmt.Errorf("error writing %s: %w", key, err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("error writing %s: %w", key, err)
+	}
+	if err := os.Rename(f.Name(), path); err != nil {
+		return fmt.Errorf("error writing %s: %w", key, err)
+	}
+	return nil
+}
+
+func (s *DirStore) path(key string) string {
+	return filepath.Join(s.dir, filepath.FromSlash(key))
+}
@@ -0,0 +1,204 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+
+	"github.com/apidepot/digikey/internal/currency"
+)
+
+// DefaultCurrency is the currency assumed for prices in responses that
+// state no currency, for requests that don't select one.
+const DefaultCurrency = "USD"
+
+// ErrCurrencyMismatch is returned by arithmetic on amounts of different
+// currencies.
+var ErrCurrencyMismatch = errors.New("currency mismatch")
+
+// Money is an amount in a currency, given as an ISO 4217 code. The API
+// returns bare amounts, so the client fills in the currency of each price
+// from the locale of the response, or the locale requested, or else
+// DefaultCurrency.
+//
+// An amount with an empty currency, such as the zero Money, takes on the
+// currency of the amount it is combined with.
+type Money struct {
+	Amount   float64
+	Currency string
+}
+
+// NewMoney returns the amount in the currency.
+func NewMoney(amount float64, currency string) Money {
+	return Money{Amount: amount, Currency: currency}
+}
+
+// String implements fmt.Stringer, e.g. "0.123 USD".
+func (m Money) String() string {
+	s := strconv.FormatFloat(m.Amount, 'f', -1, 64)
+	if m.Currency == "" {
+		return s
+	}
+	return s + " " + m.Currency
+}
+
+// IsZero reports whether the amount is zero.
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+// currency returns the currency of a sum of m and o, or an error if they
+// have different currencies.
+func (m Money) currency(o Money) (string, error) {
+	switch {
+	case m.Currency == "":
+		return o.Currency, nil
+	case o.Currency == "" || o.Currency == m.Currency:
+		return m.Currency, nil
+	default:
+		return "", fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.Currency, o.Currency)
+	}
+}
+
+// Add returns m plus o, or ErrCurrencyMismatch if their currencies differ.
+func (m Money) Add(o Money) (Money, error) {
+	currency, err := m.currency(o)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: m.Amount + o.Amount, Currency: currency}, nil
+}
+
+// Sub returns m minus o, or ErrCurrencyMismatch if their currencies differ.
+func (m Money) Sub(o Money) (Money, error) {
+	return m.Add(Money{Amount: -o.Amount, Currency: o.Currency})
+}
+
+// Mul returns m times the factor.
+func (m Money) Mul(factor float64) Money {
+	return Money{Amount: m.Amount * factor, Currency: m.Currency}
+}
+
+// Cmp compares m and o, returning -1, 0, or +1, or ErrCurrencyMismatch if
+// their currencies differ.
+func (m Money) Cmp(o Money) (int, error) {
+	if _, err := m.currency(o); err != nil {
+		return 0, err
+	}
+	switch {
+	case m.Amount < o.Amount:
+		return -1, nil
+	case m.Amount > o.Amount:
+		return 1, nil
+	default:
+		return 0, nil
+	}
+}
+
+// RoundCents returns the amount rounded to the cent.
+func (m Money) RoundCents() Money {
+	return Money{Amount: math.Round(m.Amount*100) / 100, Currency: m.Currency}
+}
+
+// moneyJSON is the JSON object of an amount with a currency.
+type moneyJSON struct {
+	Amount   float64 `json:"Amount"`
+	Currency string  `json:"Currency"`
+}
+
+// MarshalJSON implements json.Marshaler. Amounts without a currency are
+// marshaled as bare numbers, as the API returns them, and amounts with one
+// as an object with Amount and Currency, so the currency survives.
+func (m Money) MarshalJSON() ([]byte, error) {
+	if m.Currency == "" {
+		return json.Marshal(m.Amount)
+	}
+	return json.Marshal(moneyJSON(m))
+}
+
+// UnmarshalJSON implements json.Unmarshaler, accepting either form written
+// by MarshalJSON. Null unmarshals to the zero Money.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	switch {
+	case bytes.Equal(data, []byte("null")):
+		*m = Money{}
+		return nil
+	case len(data) > 0 && data[0] == '{':
+		var v moneyJSON
+		if err := json.Unmarshal(data, &v); err != nil {
+			return err
+		}
+		*m = Money(v)
+		return nil
+	default:
+		*m = Money{}
+		return json.Unmarshal(data, &m.Amount)
+	}
+}
+
+var (
+	moneyType  = reflect.TypeFor[Money]()
+	localeType = reflect.TypeFor[Locale]()
+)
+
+func init() {
+	currency.Fill = fillCurrency
+}
+
+// fillCurrency sets the currency of every amount in v that has none. The
+// currency is taken from the nearest enclosing struct that states one, in a
+// SearchLocaleUsed locale or a Currency field, or else is currency.
+func fillCurrency(v any, currency string) {
+	fillValue(reflect.ValueOf(v), currency)
+}
+
+func fillValue(v reflect.Value, currency string) {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if !v.IsNil() {
+			fillValue(v.Elem(), currency)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := range v.Len() {
+			fillValue(v.Index(i), currency)
+		}
+	case reflect.Struct:
+		if v.Type() == moneyType {
+			if f := v.Field(1); f.CanSet() && f.String() == "" {
+				f.SetString(currency)
+			}
+			return
+		}
+		if c := structCurrency(v); c != "" {
+			currency = c
+		}
+		t := v.Type()
+		for i := range v.NumField() {
+			if t.Field(i).IsExported() {
+				fillValue(v.Field(i), currency)
+			}
+		}
+	}
+}
+
+// structCurrency returns the currency a struct states for its amounts, if
+// any.
+func structCurrency(v reflect.Value) string {
+	if f := v.FieldByName("SearchLocaleUsed"); f.IsValid() && f.Type() == localeType {
+		return f.Interface().(Locale).Currency
+	}
+	if f := v.FieldByName("Currency"); f.IsValid() && f.Kind() == reflect.String {
+		return f.String()
+	}
+	return ""
+}
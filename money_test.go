@@ -0,0 +1,37 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFillCurrency(t *testing.T) {
+	body := `{"Product":{"UnitPrice":0.52,"ProductVariations":[{"StandardPricing":[{"BreakQuantity":1,"UnitPrice":0.52,"TotalPrice":0.52}]}]},"SearchLocaleUsed":{"Currency":"EUR"}}`
+	var resp struct {
+		Product          *Product
+		SearchLocaleUsed Locale
+	}
+	if err := json.Unmarshal([]byte(body), &resp); err != nil {
+		t.Fatal(err)
+	}
+	fillCurrency(&resp, DefaultCurrency)
+	p := resp.Product
+	if p.UnitPrice.Currency != "EUR" || p.ProductVariations[0].StandardPricing[0].UnitPrice.Currency != "EUR" {
+		t.Errorf("currencies = %q, %q, want the locale's EUR", p.UnitPrice.Currency, p.ProductVariations[0].StandardPricing[0].UnitPrice.Currency)
+	}
+
+	// Without a locale, amounts take the currency given, and amounts with a
+	// currency keep it.
+	p = &Product{UnitPrice: Money{Amount: 1}, ProductVariations: []ProductVariation{{
+		StandardPricing: []PriceBreak{{UnitPrice: NewMoney(1, "GBP")}},
+	}}}
+	fillCurrency(p, DefaultCurrency)
+	if p.UnitPrice.Currency != DefaultCurrency || p.ProductVariations[0].StandardPricing[0].UnitPrice.Currency != "GBP" {
+		t.Errorf("currencies = %q, %q, want %s and GBP kept", p.UnitPrice.Currency, p.ProductVariations[0].StandardPricing[0].UnitPrice.Currency, DefaultCurrency)
+	}
+}
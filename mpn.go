@@ -0,0 +1,105 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+)
+
+// ErrNoExactMatch is returned by ExactMPN when no product's manufacturer
+// product number matches exactly.
+var ErrNoExactMatch = errors.New("no exact manufacturer product number match")
+
+// PartMatch is one packaging option of a product whose manufacturer product
+// number matched a lookup.
+type PartMatch struct {
+	Product   Product
+	Variation ProductVariation
+}
+
+// ByManufacturerPartNumber returns every packaging option of the products
+// whose manufacturer product number exactly matches mpn, ignoring case and
+// surrounding whitespace. A single MPN often has several DigiKey product
+// numbers, e.g. cut tape, tape and reel, and Digi-Reel, and can be made by
+// more than one manufacturer, so each option is returned as its own match.
+func (s *ProductsService) ByManufacturerPartNumber(ctx context.Context, mpn string, opts ...RequestOption) ([]PartMatch, error) {
+	mpn = strings.TrimSpace(mpn)
+	resp, err := s.KeywordSearch(ctx, KeywordRequest{Keywords: mpn, Limit: MaxSearchLimit}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []PartMatch
+	seen := make(map[string]bool)
+	for _, products := range [][]Product{resp.ExactMatches, resp.Products} {
+		for _, p := range products {
+			if !strings.EqualFold(strings.TrimSpace(p.ManufacturerProductNumber), mpn) {
+				continue
+			}
+			for _, v := range p.ProductVariations {
+				if seen[v.DigiKeyProductNumber] {
+					continue
+				}
+				seen[v.DigiKeyProductNumber] = true
+				matches = append(matches, PartMatch{Product: p, Variation: v})
+			}
+		}
+	}
+	return matches, nil
+}
+
+// ExactMPNResult is the result of an ExactMPN lookup.
+type ExactMPNResult struct {
+	// Matches are every packaging option of the products matching the MPN.
+	Matches []PartMatch
+	// ByPackaging groups the matches by packaging type.
+	ByPackaging map[PackagingType][]PartMatch
+	// Preferred is the canonical match: the one of the most preferred
+	// packaging type, and of those the one with the most stock.
+	Preferred PartMatch
+}
+
+// ExactMPN looks up the packaging options of the products whose
+// manufacturer product number exactly matches mpn, as
+// ByManufacturerPartNumber does, groups them by packaging, and picks a
+// preferred option by the client's packaging preference, set with
+// WithPackagingPreference. It returns ErrNoExactMatch if nothing matches.
+func (s *ProductsService) ExactMPN(ctx context.Context, mpn string, opts ...RequestOption) (*ExactMPNResult, error) {
+	matches, err := s.ByManufacturerPartNumber(ctx, mpn, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if len(matches) == 0 {
+		return nil, ErrNoExactMatch
+	}
+	r := &ExactMPNResult{
+		Matches:     matches,
+		ByPackaging: make(map[PackagingType][]PartMatch),
+	}
+	for _, m := range matches {
+		t := m.Variation.PackagingType()
+		r.ByPackaging[t] = append(r.ByPackaging[t], m)
+	}
+	prefs := s.client.packagingPrefs
+	rank := func(m PartMatch) int {
+		if i := slices.Index(prefs, m.Variation.PackagingType()); i >= 0 {
+			return i
+		}
+		return len(prefs)
+	}
+	best := 0
+	for i, m := range matches[1:] {
+		b := matches[best]
+		if rank(m) < rank(b) || (rank(m) == rank(b) && m.Variation.QuantityAvailableForPackageType > b.Variation.QuantityAvailableForPackageType) {
+			best = i + 1
+		}
+	}
+	r.Preferred = matches[best]
+	return r, nil
+}
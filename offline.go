@@ -0,0 +1,60 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ErrOffline is returned by a client in offline mode for a request it
+// cannot answer from its cache. A response missing from the cache fails
+// with an error wrapping both ErrOffline and ErrCacheMiss; a request that is
+// never cached, such as creating a list, fails with ErrOffline alone.
+var ErrOffline = errors.New("client is offline")
+
+// WithOfflineMode answers every request from the client's cache, set with
+// WithCache, without contacting DigiKey, e.g. for demos, CI, and air-gapped
+// sites working from a cache filled earlier. Cached responses are served
+// however long ago they expired. No access token is requested, so the
+// credentials may be empty.
+func WithOfflineMode() ClientOption {
+	return func(client *Client) {
+		client.offline = true
+	}
+}
+
+// Offline reports whether the client is in offline mode.
+func (c *Client) Offline() bool {
+	return c.offline
+}
+
+// offlineLookup answers the request from the cache in offline mode.
+func (c *Client) offlineLookup(ctx context.Context, req *http.Request, o *requestOptions) ([]byte, error) {
+	if c.cache == nil || o.cacheMode == cacheBypass {
+		return nil, ErrOffline
+	}
+	key, err := cacheKey(req)
+	if err != nil {
+		return nil, err
+	}
+	entry, err := c.cache.Get(ctx, key)
+	if err != nil {
+		c.cacheStats.misses.Add(1)
+		if !errors.Is(err, ErrCacheMiss) {
+			c.cacheStats.errors.Add(1)
+			return nil, fmt.Errorf("%w: %w", ErrOffline, err)
+		}
+		return nil, fmt.Errorf("%w: %w", ErrOffline, ErrCacheMiss)
+	}
+	c.cacheStats.hits.Add(1)
+	if o.metadata != nil {
+		*o.metadata = ResponseMetadata{Cached: true, CacheKey: key}
+	}
+	return entry.Body, nil
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/apidepot/digikey"
+	"github.com/apidepot/digikey/digikeytest"
+)
+
+func TestOfflineMode(t *testing.T) {
+	srv := digikeytest.NewServer("id", "secret")
+	defer srv.Close()
+	cache := digikey.NewMemoryCache()
+	ctx := context.Background()
+
+	// Fill the cache online, with a TTL that has passed by the time the
+	// offline client reads it.
+	online, err := srv.NewClient("id", "secret", digikey.WithCache(cache, time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := online.Products.ProductDetails(ctx, "296-1395-1-ND"); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(5 * time.Millisecond)
+	n := len(srv.Requests())
+
+	offline, err := srv.NewClient("", "", digikey.WithCache(cache, time.Millisecond), digikey.WithOfflineMode())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !offline.Offline() {
+		t.Error("Offline() = false, want true")
+	}
+	p, err := offline.Products.ProductDetails(ctx, "296-1395-1-ND")
+	if err != nil || p.ManufacturerProductNumber != "LM358DR" {
+		t.Errorf("offline ProductDetails() of a cached product = %v, %v, want LM358DR", p, err)
+	}
+
+	_, err = offline.Products.ProductDetails(ctx, "311-10.0KHRCT-ND")
+	if !errors.Is(err, digikey.ErrOffline) || !errors.Is(err, digikey.ErrCacheMiss) {
+		t.Errorf("offline ProductDetails() of an uncached product = %v, want ErrOffline and ErrCacheMiss", err)
+	}
+	_, err = offline.Lists.List(ctx)
+	if !errors.Is(err, digikey.ErrOffline) || errors.Is(err, digikey.ErrCacheMiss) {
+		t.Errorf("offline Lists.List() = %v, want ErrOffline alone", err)
+	}
+
+	if stats := offline.CacheStats(); stats.Hits != 1 || stats.Misses != 1 {
+		t.Errorf("offline CacheStats() = %+v, want 1 hit and 1 miss", stats)
+	}
+	if got := len(srv.Requests()) - n; got != 0 {
+		t.Errorf("offline client sent %d requests, want none", got)
+	}
+}
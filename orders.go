@@ -0,0 +1,219 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import (
+	"context"
+	"iter"
+	"strconv"
+	"time"
+)
+
+const orderStatusPath = "orderstatus/v4/"
+
+// OrdersService provides access to the Order Status V4 API. Order data
+// belongs to a customer, so the client must use a token from the
+// three-legged OAuth2 flow. Orders change as they ship, so they are read
+// from the API every time, bypassing the client's cache.
+type OrdersService struct {
+	client *Client
+}
+
+// SalesOrder models a DigiKey sales order.
+type SalesOrder struct {
+	CustomerID      int         `json:"CustomerId"`
+	SalesOrderID    int         `json:"SalesOrderId"`
+	Status          OrderStatus `json:"Status"`
+	PurchaseOrder   string      `json:"PurchaseOrder"`
+	TotalPrice      Money       `json:"TotalPrice"`
+	DateEntered     Time        `json:"DateEntered"`
+	OrderNumber     int         `json:"OrderNumber"`
+	ShipMethod      string      `json:"ShipMethod"`
+	Currency        string      `json:"Currency"`
+	ShippingAddress Address     `json:"ShippingAddress"`
+	LineItems       []LineItem  `json:"LineItems"`
+	Shipments       []Shipment  `json:"Shipments"`
+}
+
+// OrderStatus is the status of a sales order.
+type OrderStatus struct {
+	SalesOrderStatus string `json:"SalesOrderStatus"`
+	ShortDescription string `json:"ShortDescription"`
+	LongDescription  string `json:"LongDescription"`
+}
+
+// Address is a postal address.
+type Address struct {
+	Company      string `json:"Company"`
+	FirstName    string `json:"FirstName"`
+	LastName     string `json:"LastName"`
+	AddressLine1 string `json:"AddressLine1"`
+	AddressLine2 string `json:"AddressLine2"`
+	AddressLine3 string `json:"AddressLine3"`
+	City         string `json:"City"`
+	State        string `json:"State"`
+	County       string `json:"County"`
+	ZipCode      string `json:"ZipCode"`
+	Country      string `json:"Country"`
+}
+
+// LineItem is a line of a sales order.
+type LineItem struct {
+	SalesOrderID              int            `json:"SalesOrderId"`
+	DetailID                  int            `json:"DetailId"`
+	TotalPrice                Money          `json:"TotalPrice"`
+	PurchaseOrder             string         `json:"PurchaseOrder"`
+	CustomerReference         string         `json:"CustomerReference"`
+	CountryOfOrigin           string         `json:"CountryOfOrigin"`
+	DigiKeyProductNumber      string         `json:"DigiKeyProductNumber"`
+	ManufacturerProductNumber string         `json:"ManufacturerProductNumber"`
+	Description               string         `json:"Description"`
+	PackType                  string         `json:"PackType"`
+	QuantityInitialRequested  int            `json:"QuantityInitialRequested"`
+	QuantityOrdered           int            `json:"QuantityOrdered"`
+	QuantityShipped           int            `json:"QuantityShipped"`
+	QuantityReserved          int            `json:"QuantityReserved"`
+	QuantityBackOrder         int            `json:"QuantityBackOrder"`
+	UnitPrice                 Money          `json:"UnitPrice"`
+	PoLineItemNumber          string         `json:"PoLineItemNumber"`
+	ItemShipments             []ItemShipment `json:"ItemShipments"`
+	Schedules                 []Schedule     `json:"Schedules"`
+}
+
+// ItemShipment is a shipment of some quantity of a line item.
+type ItemShipment struct {
+	QuantityShipped      int    `json:"QuantityShipped"`
+	InvoiceID            int    `json:"InvoiceId"`
+	ShippedDate          Time   `json:"ShippedDate"`
+	TrackingNumber       string `json:"TrackingNumber"`
+	ExpectedDeliveryDate Time   `json:"ExpectedDeliveryDate"`
+}
+
+// Schedule is a scheduled future shipment of some quantity of a line item.
+type Schedule struct {
+	QuantityScheduled int  `json:"QuantityScheduled"`
+	ScheduledDate     Time `json:"ScheduledDate"`
+}
+
+// Status returns the sales order with the given ID, including the
+// quantities shipped and backordered and the ship dates of its lines.
+func (s *OrdersService) Status(ctx context.Context, salesOrderID int, opts ...RequestOption) (*SalesOrder, error) {
+	resp := &SalesOrder{}
+	endpoint := orderStatusPath + "salesorder/" + strconv.Itoa(salesOrderID)
+	if err := s.client.getCustomerJSON(ctx, endpoint, nil, resp, opts...); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Details returns the line items of the sales order with the given ID, for
+// reconciliation against purchase orders.
+func (s *OrdersService) Details(ctx context.Context, salesOrderID int, opts ...RequestOption) ([]LineItem, error) {
+	order, err := s.Status(ctx, salesOrderID, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return order.LineItems, nil
+}
+
+// ExtendedPrice returns the total price of the line, computing it from the
+// unit price and quantity ordered if DigiKey did not return one.
+func (l LineItem) ExtendedPrice() Money {
+	if !l.TotalPrice.IsZero() {
+		return l.TotalPrice
+	}
+	return l.UnitPrice.Mul(float64(l.QuantityOrdered)).RoundCents()
+}
+
+// DefaultOrderHistoryPageSize is the page size History requests.
+const DefaultOrderHistoryPageSize = 25
+
+// OrderHistoryRequest selects a page of the orders entered in a date range.
+// PageNumber starts at 1. Shared includes orders shared with the customer by
+// other members of their organization.
+type OrderHistoryRequest struct {
+	From       time.Time
+	To         time.Time
+	Shared     bool
+	PageNumber int
+	PageSize   int
+}
+
+// OrderHistoryPage is a page of orders.
+type OrderHistoryPage struct {
+	Orders      []SalesOrder `json:"Orders"`
+	TotalOrders int          `json:"TotalOrders"`
+}
+
+// HistoryPage returns a single page of the orders entered in the request's
+// date range.
+func (s *OrdersService) HistoryPage(ctx context.Context, req OrderHistoryRequest, opts ...RequestOption) (*OrderHistoryPage, error) {
+	params := map[string]string{
+		"StartDate":  req.From.Format(time.DateOnly),
+		"EndDate":    req.To.Format(time.DateOnly),
+		"Shared":     strconv.FormatBool(req.Shared),
+		"PageNumber": strconv.Itoa(max(req.PageNumber, 1)),
+	}
+	if req.PageSize > 0 {
+		params["PageSize"] = strconv.Itoa(req.PageSize)
+	}
+	resp := &OrderHistoryPage{}
+	if err := s.client.getCustomerJSON(ctx, orderStatusPath+"orders", params, resp, opts...); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// History returns an iterator over every order entered from the start of
+// the from date to the end of the to date, requesting one page at a time.
+// Iteration stops after yielding the first error.
+func (s *OrdersService) History(ctx context.Context, from, to time.Time, opts ...RequestOption) iter.Seq2[SalesOrder, error] {
+	return func(yield func(SalesOrder, error) bool) {
+		req := OrderHistoryRequest{
+			From:       from,
+			To:         to,
+			PageNumber: 1,
+			PageSize:   DefaultOrderHistoryPageSize,
+		}
+		seen := 0
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(SalesOrder{}, err)
+				return
+			}
+			page, err := s.HistoryPage(ctx, req, opts...)
+			if err != nil {
+				yield(SalesOrder{}, err)
+				return
+			}
+			for _, o := range page.Orders {
+				if !yield(o, nil) {
+					return
+				}
+			}
+			seen += len(page.Orders)
+			if len(page.Orders) < req.PageSize || seen >= page.TotalOrders {
+				return
+			}
+			req.PageNumber++
+		}
+	}
+}
+
+// Shipped reports whether every ordered unit of the line has shipped.
+func (l LineItem) Shipped() bool {
+	return l.QuantityShipped >= l.QuantityOrdered
+}
+
+// Shipped reports whether every line of the order has shipped.
+func (o *SalesOrder) Shipped() bool {
+	for _, l := range o.LineItems {
+		if !l.Shipped() {
+			return false
+		}
+	}
+	return true
+}
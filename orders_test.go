@@ -0,0 +1,50 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestOrdersBypassCache(t *testing.T) {
+	// Each request finds one more unit shipped.
+	var shipped atomic.Int32
+	h := func(w http.ResponseWriter, r *http.Request) {
+		order := SalesOrder{SalesOrderID: 1, LineItems: []LineItem{{QuantityOrdered: 10, QuantityShipped: int(shipped.Add(1))}}}
+		switch r.URL.Path {
+		case "/" + orderStatusPath + "salesorder/1":
+			json.NewEncoder(w).Encode(order)
+		case "/" + orderStatusPath + "orders":
+			json.NewEncoder(w).Encode(OrderHistoryPage{Orders: []SalesOrder{order}, TotalOrders: 1})
+		default:
+			http.NotFound(w, r)
+		}
+	}
+	c := newTestClient(t, h, WithCache(NewMemoryCache(), time.Hour))
+	ctx := context.Background()
+
+	for want := 1; want <= 2; want++ {
+		order, err := c.Orders.Status(ctx, 1)
+		if err != nil || order.LineItems[0].QuantityShipped != want {
+			t.Fatalf("Status() = %+v, %v, want %d shipped", order, err, want)
+		}
+	}
+	req := OrderHistoryRequest{From: time.Now().AddDate(0, -1, 0), To: time.Now()}
+	for want := 3; want <= 4; want++ {
+		page, err := c.Orders.HistoryPage(ctx, req)
+		if err != nil || page.Orders[0].LineItems[0].QuantityShipped != want {
+			t.Fatalf("HistoryPage() = %+v, %v, want %d shipped", page, err, want)
+		}
+	}
+	if stats := c.CacheStats(); stats.Hits != 0 || stats.Misses != 0 || stats.Stores != 0 {
+		t.Errorf("CacheStats() = %+v, want the cache untouched", stats)
+	}
+}
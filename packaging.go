@@ -0,0 +1,97 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// PackagingType is a kind of packaging, identified by its DigiKey
+// packaging ID as used in PackagingFilter and ProductVariation.PackageType.
+type PackagingType int
+
+// Packaging types.
+const (
+	TapeAndReel PackagingType = 1
+	CutTape     PackagingType = 2
+	Tray        PackagingType = 3
+	Tube        PackagingType = 4
+	DigiReel    PackagingType = 243
+)
+
+// DefaultPackagingPreference is the order in which ExactMPN prefers
+// packaging types, unless changed with WithPackagingPreference: cut tape
+// for any quantity, then full reels, then the rest.
+var DefaultPackagingPreference = []PackagingType{CutTape, TapeAndReel, DigiReel, Tray, Tube}
+
+// WithPackagingPreference sets the order in which ExactMPN prefers
+// packaging types when an MPN has several. Types not listed rank after
+// those listed.
+func WithPackagingPreference(types ...PackagingType) ClientOption {
+	return func(client *Client) {
+		client.packagingPrefs = slices.Clone(types)
+	}
+}
+
+var packagingNames = map[PackagingType]string{
+	TapeAndReel: "Tape & Reel (TR)",
+	CutTape:     "Cut Tape (CT)",
+	Tray:        "Tray",
+	Tube:        "Tube",
+	DigiReel:    "Digi-Reel®",
+}
+
+// packagingAliases maps the lower-case friendly names ParsePackagingType
+// accepts to packaging types.
+var packagingAliases = map[string]PackagingType{
+	"tape & reel":      TapeAndReel,
+	"tape and reel":    TapeAndReel,
+	"tape & reel (tr)": TapeAndReel,
+	"tr":               TapeAndReel,
+	"cut tape":         CutTape,
+	"cut tape (ct)":    CutTape,
+	"ct":               CutTape,
+	"tray":             Tray,
+	"tube":             Tube,
+	"digi-reel":        DigiReel,
+	"digi-reel®":       DigiReel,
+	"digireel":         DigiReel,
+	"dkr":              DigiReel,
+}
+
+// ParsePackagingType parses a friendly packaging name, such as "Cut Tape",
+// "tape and reel", "TR", or "Digi-Reel", case-insensitively.
+func ParsePackagingType(name string) (PackagingType, error) {
+	if t, ok := packagingAliases[strings.ToLower(strings.TrimSpace(name))]; ok {
+		return t, nil
+	}
+	return 0, fmt.Errorf("unknown packaging type %q", name)
+}
+
+// String implements fmt.Stringer, returning the name DigiKey uses.
+func (t PackagingType) String() string {
+	if name, ok := packagingNames[t]; ok {
+		return name
+	}
+	return fmt.Sprintf("PackagingType(%d)", int(t))
+}
+
+// PackagingType returns the packaging type of the variation.
+func (v ProductVariation) PackagingType() PackagingType {
+	return PackagingType(v.PackageType.ID)
+}
+
+// PackagingTypes limits the results to products available in any of the
+// packaging types.
+func (r *SearchRequest) PackagingTypes(types ...PackagingType) *SearchRequest {
+	ids := make([]int, len(types))
+	for i, t := range types {
+		ids[i] = int(t)
+	}
+	return r.Packaging(ids...)
+}
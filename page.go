@@ -0,0 +1,104 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidPage is returned for a page DigiKey would reject, such as one
+// with a negative offset or a limit above MaxSearchLimit.
+var ErrInvalidPage = errors.New("invalid page")
+
+// Page is a window of keyword search results: up to Limit products
+// starting at the zero-based Offset. Pages are values, so paging through
+// results is
+//
+//	for p := digikey.FirstPage(digikey.MaxSearchLimit); ; p = p.Next() {
+//		resp, err := c.Products.KeywordSearch(ctx, req.Page(p).Build())
+//		...
+//		if !p.HasMore(resp.ProductsCount) {
+//			break
+//		}
+//	}
+type Page struct {
+	Offset int
+	Limit  int
+}
+
+// FirstPage returns the first page of limit products.
+func FirstPage(limit int) Page {
+	return Page{Limit: limit}
+}
+
+// Validate returns an error wrapping ErrInvalidPage unless the offset is
+// non-negative and the limit is between 1 and MaxSearchLimit.
+func (p Page) Validate() error {
+	if p.Offset < 0 {
+		return fmt.Errorf("%w: negative offset %d", ErrInvalidPage, p.Offset)
+	}
+	if p.Limit < 1 || p.Limit > MaxSearchLimit {
+		return fmt.Errorf("%w: limit %d is not between 1 and %d", ErrInvalidPage, p.Limit, MaxSearchLimit)
+	}
+	return nil
+}
+
+// Next returns the page following p, of the same size.
+func (p Page) Next() Page {
+	return Page{Offset: p.Offset + p.Limit, Limit: p.Limit}
+}
+
+// Number returns the zero-based index of the page, assuming every earlier
+// page had the same limit.
+func (p Page) Number() int {
+	if p.Limit <= 0 {
+		return 0
+	}
+	return p.Offset / p.Limit
+}
+
+// HasMore reports whether results remain after p, given the total number
+// of results, e.g. a search response's ProductsCount.
+func (p Page) HasMore(total int) bool {
+	return p.Offset+p.Limit < total
+}
+
+// PageCount returns the number of pages of limit results needed to cover
+// total results.
+func PageCount(total, limit int) int {
+	if total <= 0 || limit <= 0 {
+		return 0
+	}
+	return (total + limit - 1) / limit
+}
+
+// Page returns the page of results the request asks for.
+func (r KeywordRequest) Page() Page {
+	return Page{Offset: r.Offset, Limit: r.Limit}
+}
+
+// validate checks the request's page and sort options before the request
+// is sent. A zero limit leaves the page size to DigiKey.
+func (r KeywordRequest) validate() error {
+	p := r.Page()
+	if p.Limit == 0 {
+		p.Limit = MaxSearchLimit
+	}
+	if err := p.Validate(); err != nil {
+		return err
+	}
+	if r.SortOptions != nil {
+		return r.SortOptions.Validate()
+	}
+	return nil
+}
+
+// Page sets the offset and limit of the results from the page.
+func (r *SearchRequest) Page(p Page) *SearchRequest {
+	r.req.Offset, r.req.Limit = p.Offset, p.Limit
+	return r
+}
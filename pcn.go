@@ -0,0 +1,42 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import (
+	"context"
+	"net/url"
+)
+
+const changeNotificationsPath = "ChangeNotifications/v3/Products/"
+
+// ProductChangeNotification is a manufacturer notice of a change to a
+// product, such as a discontinuation, a packaging change, or a change of
+// assembly site. PcnType describes the kind of change.
+type ProductChangeNotification struct {
+	ID            int    `json:"ProductChangeNotificationId"`
+	PcnType       string `json:"PcnType"`
+	Description   string `json:"Description"`
+	URL           string `json:"Url"`
+	PublishedDate string `json:"PublishedDate"`
+}
+
+// changeNotificationsResponse is the response to a product change
+// notifications request.
+type changeNotificationsResponse struct {
+	DigiKeyPartNumber          string                      `json:"DigiKeyPartNumber"`
+	ProductChangeNotifications []ProductChangeNotification `json:"ProductChangeNotifications"`
+}
+
+// ChangeNotifications returns the product change notifications (PCNs) for
+// the product with the given DigiKey product number.
+func (s *ProductsService) ChangeNotifications(ctx context.Context, digiKeyPartNumber string, opts ...RequestOption) ([]ProductChangeNotification, error) {
+	resp := changeNotificationsResponse{}
+	endpoint := changeNotificationsPath + url.PathEscape(digiKeyPartNumber)
+	if err := s.client.getJSON(ctx, endpoint, nil, &resp, opts...); err != nil {
+		return nil, err
+	}
+	return resp.ProductChangeNotifications, nil
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// pingPath is the endpoint Ping calls. It needs no part number and its
+// response is the same for every caller.
+const pingPath = productsPath + "categories"
+
+// PingStatus is the result of a successful Ping.
+type PingStatus struct {
+	// Latency is the time the authenticated request took.
+	Latency time.Duration
+	// RequestID is the ID DigiKey assigned to the request, if any.
+	RequestID string
+	// RateLimit and RateLimitRemaining are the daily request limit and the
+	// number of requests remaining, or -1 if DigiKey did not report them.
+	RateLimit          int
+	RateLimitRemaining int
+	// QuotaAvailable is the number of requests left unused and unreserved
+	// by the client's quota tracker, or -1 if it has none.
+	QuotaAvailable int
+	// TokenExpiresAt is when the client's access token expires.
+	TokenExpiresAt time.Time
+}
+
+// Ping checks that the API is reachable with the client's credentials, for
+// use in readiness checks. It obtains an access token, if the client has
+// none, and sends one authenticated request, bypassing the cache, whose
+// body it does not read. The request counts against the daily quota.
+func (c *Client) Ping(ctx context.Context) (*PingStatus, error) {
+	if _, err := c.getAccessToken(ctx); err != nil {
+		return nil, err
+	}
+	u, err := c.url(pingPath, nil)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	var meta ResponseMetadata
+	body, err := c.stream(ctx, req, BypassCache(), WithResponseMetadata(&meta))
+	if err != nil {
+		return nil, err
+	}
+	body.Close()
+
+	status := &PingStatus{
+		Latency:            meta.Latency,
+		RequestID:          meta.RequestID,
+		RateLimit:          meta.RateLimit,
+		RateLimitRemaining: meta.RateLimitRemaining,
+		QuotaAvailable:     -1,
+		TokenExpiresAt:     c.TokenExpiresAt(),
+	}
+	if c.quota != nil {
+		status.QuotaAvailable = c.quota.Available()
+	}
+	return status, nil
+}
@@ -0,0 +1,150 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import (
+	"context"
+	"errors"
+)
+
+// Errors returned by the pricing functions.
+var (
+	ErrInvalidQuantity           = errors.New("quantity must be positive")
+	ErrBelowMinimumOrderQuantity = errors.New("quantity is below the minimum order quantity")
+)
+
+// ProductPricing contains the pricing of each packaging option of a product.
+type ProductPricing struct {
+	ManufacturerProductNumber  string             `json:"ManufacturerProductNumber"`
+	Manufacturer               Manufacturer       `json:"Manufacturer"`
+	Description                Description        `json:"Description"`
+	QuantityAvailable          int                `json:"QuantityAvailable"`
+	ProductURL                 string             `json:"ProductUrl"`
+	IsDiscontinued             bool               `json:"IsDiscontinued"`
+	NormallyStocking           bool               `json:"NormallyStocking"`
+	IsObsolete                 bool               `json:"IsObsolete"`
+	ManufacturerLeadWeeks      string             `json:"ManufacturerLeadWeeks"`
+	ManufacturerPublicQuantity int                `json:"ManufacturerPublicQuantity"`
+	StandardPackage            int                `json:"StandardPackage"`
+	ProductVariations          []ProductVariation `json:"ProductVariations"`
+}
+
+// productPricingResponse is the response to a product pricing request.
+type productPricingResponse struct {
+	ProductPricings []ProductPricing `json:"ProductPricings"`
+	ProductsCount   int              `json:"ProductsCount"`
+}
+
+// Pricing returns the standard and customer-specific price breaks of each
+// packaging option of the products matching the DigiKey or manufacturer
+// product number.
+func (s *ProductsService) Pricing(ctx context.Context, partNumber string, opts ...RequestOption) ([]ProductPricing, error) {
+	resp := productPricingResponse{}
+	if err := s.client.getJSON(ctx, productPath(partNumber, "pricing"), nil, &resp, opts...); err != nil {
+		return nil, err
+	}
+	return resp.ProductPricings, nil
+}
+
+// UnitPriceAt returns the unit price of the largest price break at or below
+// qty. The breaks need not be sorted. It returns false if qty is below every
+// break.
+func UnitPriceAt(breaks []PriceBreak, qty int) (Money, bool) {
+	best := -1
+	for i, b := range breaks {
+		if b.BreakQuantity <= qty && (best < 0 || b.BreakQuantity > breaks[best].BreakQuantity) {
+			best = i
+		}
+	}
+	if best < 0 {
+		return Money{}, false
+	}
+	return breaks[best].UnitPrice, true
+}
+
+// ExtendedPrice returns the price of ordering qty units given the price
+// breaks, the minimum order quantity, and a reeling fee, which is charged
+// once per order and is zero for packaging other than Digi-Reel. The unit
+// price times the quantity is rounded to the cent before the fee is added.
+// It returns ErrCurrencyMismatch if the fee is in another currency than the
+// breaks.
+func ExtendedPrice(breaks []PriceBreak, qty, moq int, reelingFee Money) (Money, error) {
+	if qty <= 0 {
+		return Money{}, ErrInvalidQuantity
+	}
+	if qty < moq {
+		return Money{}, ErrBelowMinimumOrderQuantity
+	}
+	unit, ok := UnitPriceAt(breaks, qty)
+	if !ok {
+		return Money{}, ErrBelowMinimumOrderQuantity
+	}
+	return unit.Mul(float64(qty)).RoundCents().Add(reelingFee)
+}
+
+// OrderPlan is the cheapest way to order at least a required quantity.
+type OrderPlan struct {
+	// Quantity is the number of units to order, which may exceed the
+	// required quantity when a larger price break is cheaper overall.
+	Quantity      int
+	UnitPrice     Money
+	ExtendedPrice Money
+	// BaselinePrice is the extended price of ordering the required
+	// quantity, raised to the minimum order quantity or smallest break.
+	BaselinePrice Money
+	// Savings is BaselinePrice less ExtendedPrice.
+	Savings Money
+}
+
+// OptimalOrder returns the cheapest order of at least qty units given the
+// price breaks, the minimum order quantity, and a reeling fee. Besides qty
+// itself, it considers ordering up to each larger break, since buying more
+// units at a lower unit price sometimes costs less. Ties go to the smaller
+// quantity.
+func OptimalOrder(breaks []PriceBreak, qty, moq int, reelingFee Money) (OrderPlan, error) {
+	if qty <= 0 {
+		return OrderPlan{}, ErrInvalidQuantity
+	}
+	// Ordering below the smallest break is impossible, so start there.
+	base := max(qty, moq, minBreak(breaks))
+	baseline, err := ExtendedPrice(breaks, base, moq, reelingFee)
+	if err != nil {
+		return OrderPlan{}, err
+	}
+
+	plan := OrderPlan{Quantity: base, ExtendedPrice: baseline}
+	for _, b := range breaks {
+		if b.BreakQuantity <= base {
+			continue
+		}
+		price, err := ExtendedPrice(breaks, b.BreakQuantity, moq, reelingFee)
+		if err != nil {
+			continue
+		}
+		if price.Amount < plan.ExtendedPrice.Amount || (price.Amount == plan.ExtendedPrice.Amount && b.BreakQuantity < plan.Quantity) {
+			plan.Quantity, plan.ExtendedPrice = b.BreakQuantity, price
+		}
+	}
+	plan.UnitPrice, _ = UnitPriceAt(breaks, plan.Quantity)
+	plan.BaselinePrice = baseline
+	savings, err := baseline.Sub(plan.ExtendedPrice)
+	if err != nil {
+		return OrderPlan{}, err
+	}
+	plan.Savings = savings.RoundCents()
+	return plan, nil
+}
+
+// minBreak returns the smallest break quantity, or zero if there are none.
+func minBreak(breaks []PriceBreak) int {
+	m := 0
+	for i, b := range breaks {
+		if i == 0 || b.BreakQuantity < m {
+			m = b.BreakQuantity
+		}
+	}
+	return m
+}
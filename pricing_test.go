@@ -0,0 +1,29 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey_test
+
+import (
+	"testing"
+
+	"github.com/apidepot/digikey"
+	"github.com/apidepot/digikey/digikeytest"
+)
+
+func TestExtendedPrice(t *testing.T) {
+	digikeytest.VerifyPricing(t, digikey.ExtendedPrice)
+}
+
+func TestUnitPriceAt(t *testing.T) {
+	for _, v := range digikeytest.PricingVectors {
+		if v.Err != nil {
+			continue
+		}
+		got, ok := digikey.UnitPriceAt(v.Breaks, v.Quantity)
+		if !ok || got != v.UnitPrice {
+			t.Errorf("%s: UnitPriceAt(%d) = %v, %v, want %v", v.Name, v.Quantity, got, ok, v.UnitPrice)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+// Product models a product returned by the Product Information V4 API.
+type Product struct {
+	Description                Description        `json:"Description"`
+	Manufacturer               Manufacturer       `json:"Manufacturer"`
+	ManufacturerProductNumber  string             `json:"ManufacturerProductNumber"`
+	UnitPrice                  Money              `json:"UnitPrice"`
+	ProductURL                 string             `json:"ProductUrl"`
+	DatasheetURL               string             `json:"DatasheetUrl"`
+	PhotoURL                   string             `json:"PhotoUrl"`
+	ProductVariations          []ProductVariation `json:"ProductVariations"`
+	QuantityAvailable          int                `json:"QuantityAvailable"`
+	ProductStatus              ProductStatus      `json:"ProductStatus"`
+	BackOrderNotAllowed        bool               `json:"BackOrderNotAllowed"`
+	NormallyStocking           bool               `json:"NormallyStocking"`
+	Discontinued               bool               `json:"Discontinued"`
+	EndOfLife                  bool               `json:"EndOfLife"`
+	NCNR                       bool               `json:"Ncnr"`
+	PrimaryVideoURL            string             `json:"PrimaryVideoUrl"`
+	Parameters                 []Parameter        `json:"Parameters"`
+	BaseProductNumber          IDName             `json:"BaseProductNumber"`
+	Category                   Category           `json:"Category"`
+	DateLastBuyChance          string             `json:"DateLastBuyChance"`
+	ManufacturerLeadWeeks      string             `json:"ManufacturerLeadWeeks"`
+	ManufacturerPublicQuantity int                `json:"ManufacturerPublicQuantity"`
+	Series                     IDName             `json:"Series"`
+	ShippingInfo               string             `json:"ShippingInfo"`
+	Classifications            Classifications    `json:"Classifications"`
+	OtherNames                 []string           `json:"OtherNames"`
+}
+
+// Description contains the short and detailed product descriptions.
+type Description struct {
+	ProductDescription  string `json:"ProductDescription"`
+	DetailedDescription string `json:"DetailedDescription"`
+}
+
+// Manufacturer identifies a product manufacturer.
+type Manufacturer struct {
+	ID   int    `json:"Id"`
+	Name string `json:"Name"`
+}
+
+// IDName is the generic ID and name pair used throughout the API.
+type IDName struct {
+	ID   int    `json:"Id"`
+	Name string `json:"Name"`
+}
+
+// ProductStatus contains the lifecycle status of a product.
+type ProductStatus struct {
+	ID     int    `json:"Id"`
+	Status string `json:"Status"`
+}
+
+// ProductVariation models one packaging option of a product, each of which
+// has its own DigiKey product number and pricing.
+type ProductVariation struct {
+	DigiKeyProductNumber            string       `json:"DigiKeyProductNumber"`
+	PackageType                     IDName       `json:"PackageType"`
+	StandardPricing                 []PriceBreak `json:"StandardPricing"`
+	MyPricing                       []PriceBreak `json:"MyPricing"`
+	MarketPlace                     bool         `json:"MarketPlace"`
+	TariffActive                    bool         `json:"TariffActive"`
+	Supplier                        IDName       `json:"Supplier"`
+	QuantityAvailableForPackageType int          `json:"QuantityAvailableforPackageType"`
+	MaxQuantityForDistribution      int          `json:"MaxQuantityForDistribution"`
+	MinimumOrderQuantity            int          `json:"MinimumOrderQuantity"`
+	StandardPackage                 int          `json:"StandardPackage"`
+	DigiReelFee                     Money        `json:"DigiReelFee"`
+}
+
+// PriceBreak is the unit price applicable from BreakQuantity upwards.
+type PriceBreak struct {
+	BreakQuantity int   `json:"BreakQuantity"`
+	UnitPrice     Money `json:"UnitPrice"`
+	TotalPrice    Money `json:"TotalPrice"`
+}
+
+// Parameter is a single parametric value of a product.
+type Parameter struct {
+	ParameterID   int    `json:"ParameterId"`
+	ParameterText string `json:"ParameterText"`
+	ParameterType string `json:"ParameterType"`
+	ValueID       string `json:"ValueId"`
+	ValueText     string `json:"ValueText"`
+}
+
+// Category models a product category, which may contain child categories.
+type Category struct {
+	CategoryID      int        `json:"CategoryId"`
+	ParentID        int        `json:"ParentId"`
+	Name            string     `json:"Name"`
+	ProductCount    int        `json:"ProductCount"`
+	NewProductCount int        `json:"NewProductCount"`
+	ImageURL        string     `json:"ImageUrl"`
+	SeoDescription  string     `json:"SeoDescription"`
+	ChildCategories []Category `json:"ChildCategories"`
+}
+
+// Classifications contains the environmental and export classifications of
+// a product.
+type Classifications struct {
+	ReachStatus              string `json:"ReachStatus"`
+	RohsStatus               string `json:"RohsStatus"`
+	MoistureSensitivityLevel string `json:"MoistureSensitivityLevel"`
+	ExportControlClassNumber string `json:"ExportControlClassNumber"`
+	HtsusCode                string `json:"HtsusCode"`
+}
+
+// Locale is the site, language, and currency used to answer a request.
+type Locale struct {
+	Site     string `json:"Site"`
+	Language string `json:"Language"`
+	Currency string `json:"Currency"`
+}
@@ -0,0 +1,212 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import (
+	"context"
+	"iter"
+	"net/url"
+)
+
+const productsPath = "products/v4/search/"
+
+// MaxSearchLimit is the maximum number of products DigiKey returns for a
+// single keyword search request.
+const MaxSearchLimit = 50
+
+// ProductsService provides access to the Product Information V4 API.
+type ProductsService struct {
+	client        *Client
+	manufacturers manufacturerList
+}
+
+// productPath returns the endpoint of a product resource, escaping the part
+// number, which may contain reserved characters such as '/'.
+func productPath(partNumber, resource string) string {
+	return productsPath + url.PathEscape(partNumber) + "/" + resource
+}
+
+// KeywordRequest is the request body for a keyword search.
+type KeywordRequest struct {
+	Keywords             string                `json:"Keywords"`
+	Limit                int                   `json:"Limit"`
+	Offset               int                   `json:"Offset"`
+	FilterOptionsRequest *FilterOptionsRequest `json:"FilterOptionsRequest,omitempty"`
+	SortOptions          *SortOptions          `json:"SortOptions,omitempty"`
+
+	// subtrees are the IDs of the categories whose descendants are added
+	// to the category filter before the request is sent.
+	subtrees []int
+	// manufacturerNames are the names of the manufacturers whose IDs are
+	// added to the manufacturer filter before the request is sent.
+	manufacturerNames []string
+}
+
+// FilterOptionsRequest narrows the products returned by a keyword search.
+type FilterOptionsRequest struct {
+	ManufacturerFilter       []FilterID              `json:"ManufacturerFilter,omitempty"`
+	CategoryFilter           []FilterID              `json:"CategoryFilter,omitempty"`
+	StatusFilter             []FilterID              `json:"StatusFilter,omitempty"`
+	PackagingFilter          []FilterID              `json:"PackagingFilter,omitempty"`
+	MarketPlaceFilter        string                  `json:"MarketPlaceFilter,omitempty"`
+	SeriesFilter             []FilterID              `json:"SeriesFilter,omitempty"`
+	MinimumQuantityAvailable int                     `json:"MinimumQuantityAvailable,omitempty"`
+	ParameterFilterRequest   *ParameterFilterRequest `json:"ParameterFilterRequest,omitempty"`
+	SearchOptions            []string                `json:"SearchOptions,omitempty"`
+}
+
+// FilterID identifies a filter value by its DigiKey ID.
+type FilterID struct {
+	ID string `json:"Id"`
+}
+
+// ParameterFilterRequest filters products in a category by parametric values.
+type ParameterFilterRequest struct {
+	CategoryFilter   FilterID          `json:"CategoryFilter"`
+	ParameterFilters []ParameterFilter `json:"ParameterFilters,omitempty"`
+}
+
+// ParameterFilter selects the allowed values of a single parameter.
+type ParameterFilter struct {
+	ParameterID  int        `json:"ParameterId"`
+	FilterValues []FilterID `json:"FilterValues"`
+}
+
+// SortOptions orders the products returned by a keyword search.
+type SortOptions struct {
+	Field     SortField     `json:"Field"`
+	SortOrder SortDirection `json:"SortOrder"`
+}
+
+// KeywordResponse is the response to a keyword search.
+type KeywordResponse struct {
+	Products         []Product `json:"Products"`
+	ProductsCount    int       `json:"ProductsCount"`
+	ExactMatches     []Product `json:"ExactMatches"`
+	SearchLocaleUsed Locale    `json:"SearchLocaleUsed"`
+	// FilterOptions are the filters available to narrow the search.
+	FilterOptions FilterOptions `json:"FilterOptions"`
+	// Suggestions are the corrected keywords DigiKey suggests, usually when
+	// the search matched no products.
+	Suggestions []string `json:"Suggestions,omitempty"`
+}
+
+// DidYouMean returns the first suggested keywords when the search matched
+// no products, for a UI to offer in place of the search, or "" if there
+// are none.
+func (r *KeywordResponse) DidYouMean() string {
+	if r.ProductsCount > 0 || len(r.Suggestions) == 0 {
+		return ""
+	}
+	return r.Suggestions[0]
+}
+
+// KeywordSearch searches for products matching the keywords and filters of
+// the request.
+func (s *ProductsService) KeywordSearch(ctx context.Context, req KeywordRequest, opts ...RequestOption) (*KeywordResponse, error) {
+	if err := req.validate(); err != nil {
+		return nil, err
+	}
+	req, err := s.resolve(ctx, req, opts)
+	if err != nil {
+		return nil, err
+	}
+	if s.version(opts) == V3 {
+		return s.keywordSearchV3(ctx, req, opts)
+	}
+	resp := &KeywordResponse{}
+	if err := s.client.postJSON(ctx, productsPath+"keyword", req, resp, opts...); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Count returns the number of products matching the keywords and filters
+// of the request, to estimate the size of a crawl before paging through
+// it. The request's limit, offset, and sort are ignored. Only the smallest
+// page is requested: no products from the V3 API, which takes a record
+// count of zero, and a single product from V4.
+func (s *ProductsService) Count(ctx context.Context, req KeywordRequest, opts ...RequestOption) (int, error) {
+	req.Limit, req.Offset, req.SortOptions = 1, 0, nil
+	if s.version(opts) == V3 {
+		req.Limit = 0
+	}
+	resp, err := s.KeywordSearch(ctx, req, opts...)
+	if err != nil {
+		return 0, err
+	}
+	return resp.ProductsCount, nil
+}
+
+// productDetailsResponse is the response to a product details request.
+type productDetailsResponse struct {
+	Product          Product `json:"Product"`
+	SearchLocaleUsed Locale  `json:"SearchLocaleUsed"`
+}
+
+// ProductDetails returns the product with the given DigiKey or manufacturer
+// product number.
+func (s *ProductsService) ProductDetails(ctx context.Context, partNumber string, opts ...RequestOption) (*Product, error) {
+	if s.version(opts) == V3 {
+		p, err := s.ProductDetailsV3(ctx, partNumber, opts...)
+		if err != nil {
+			return nil, err
+		}
+		product := p.ToProduct()
+		return &product, nil
+	}
+	resp := productDetailsResponse{}
+	if err := s.client.getJSON(ctx, productPath(partNumber, "productdetails"), nil, &resp, opts...); err != nil {
+		return nil, err
+	}
+	return &resp.Product, nil
+}
+
+// KeywordSearchAll returns an iterator over every product matching the
+// request, advancing the request's Offset one page at a time until the
+// results are exhausted. A zero Limit requests pages of MaxSearchLimit
+// products; a limit above it fails with ErrInvalidPage. Iteration stops
+// after yielding the first error, including the context being cancelled.
+func (s *ProductsService) KeywordSearchAll(ctx context.Context, req KeywordRequest, opts ...RequestOption) iter.Seq2[Product, error] {
+	return func(yield func(Product, error) bool) {
+		if req.Limit == 0 {
+			req.Limit = MaxSearchLimit
+		}
+		page := req.Page()
+		if err := page.Validate(); err != nil {
+			yield(Product{}, err)
+			return
+		}
+		// Resolve categories and manufacturers once rather than for every
+		// page.
+		req, err := s.resolve(ctx, req, opts)
+		if err != nil {
+			yield(Product{}, err)
+			return
+		}
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(Product{}, err)
+				return
+			}
+			resp, err := s.KeywordSearch(ctx, req, opts...)
+			if err != nil {
+				yield(Product{}, err)
+				return
+			}
+			for _, p := range resp.Products {
+				if !yield(p, nil) {
+					return
+				}
+			}
+			if len(resp.Products) < page.Limit || !page.HasMore(resp.ProductsCount) {
+				return
+			}
+			page = page.Next()
+			req.Offset = page.Offset
+		}
+	}
+}
@@ -0,0 +1,223 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+)
+
+const productsV3Path = "Search/v3/Products/"
+
+// APIVersion is a version of the Product Information API. DigiKey runs V3
+// and V4 concurrently with different schemas.
+type APIVersion int
+
+// Product Information API versions.
+const (
+	V3 APIVersion = 3
+	V4 APIVersion = 4
+)
+
+// WithProductsAPIVersion sets the version of the Product Information API
+// used by KeywordSearch and ProductDetails, and the functions built on them.
+// The default is V4. V3 responses are converted to the V4 models, so code
+// can switch versions without other changes; the other product endpoints
+// are only available in V4.
+func WithProductsAPIVersion(v APIVersion) ClientOption {
+	return func(client *Client) {
+		client.productsVersion = v
+	}
+}
+
+// UseAPIVersion overrides the client's Product Information API version for
+// a single request.
+func UseAPIVersion(v APIVersion) RequestOption {
+	return func(o *requestOptions) {
+		o.apiVersion = v
+	}
+}
+
+// version returns the Product Information API version to use for a
+// request.
+func (s *ProductsService) version(opts []RequestOption) APIVersion {
+	if v := newRequestOptions(opts).apiVersion; v != 0 {
+		return v
+	}
+	if s.client.productsVersion != 0 {
+		return s.client.productsVersion
+	}
+	return V4
+}
+
+// PidVid is the parameter and value pair used throughout the V3 API.
+type PidVid struct {
+	ParameterID int    `json:"ParameterId"`
+	ValueID     string `json:"ValueId"`
+	Parameter   string `json:"Parameter"`
+	Value       string `json:"Value"`
+}
+
+// ProductV3 models a product returned by the Product Information V3 API,
+// in which each packaging option is a separate product.
+type ProductV3 struct {
+	DigiKeyPartNumber          string       `json:"DigiKeyPartNumber"`
+	ManufacturerPartNumber     string       `json:"ManufacturerPartNumber"`
+	Manufacturer               PidVid       `json:"Manufacturer"`
+	ProductDescription         string       `json:"ProductDescription"`
+	DetailedDescription        string       `json:"DetailedDescription"`
+	UnitPrice                  Money        `json:"UnitPrice"`
+	StandardPricing            []PriceBreak `json:"StandardPricing"`
+	QuantityAvailable          int          `json:"QuantityAvailable"`
+	MinimumOrderQuantity       int          `json:"MinimumOrderQuantity"`
+	MaxQuantityForDistribution int          `json:"MaxQuantityForDistribution"`
+	Packaging                  PidVid       `json:"Packaging"`
+	Category                   PidVid       `json:"Category"`
+	Family                     PidVid       `json:"Family"`
+	Series                     PidVid       `json:"Series"`
+	Parameters                 []PidVid     `json:"Parameters"`
+	ProductStatus              string       `json:"ProductStatus"`
+	ProductURL                 string       `json:"ProductUrl"`
+	PrimaryDatasheet           string       `json:"PrimaryDatasheet"`
+	PrimaryPhoto               string       `json:"PrimaryPhoto"`
+	PrimaryVideo               string       `json:"PrimaryVideo"`
+	RoHSStatus                 string       `json:"RoHSStatus"`
+	ReachStatus                string       `json:"ReachStatus"`
+	ManufacturerLeadWeeks      string       `json:"ManufacturerLeadWeeks"`
+	ManufacturerPublicQuantity int          `json:"ManufacturerPublicQuantity"`
+	NonStock                   bool         `json:"NonStock"`
+	BackOrderNotAllowed        bool         `json:"BackOrderNotAllowed"`
+	Marketplace                bool         `json:"Marketplace"`
+	IsNcnr                     bool         `json:"IsNcnr"`
+}
+
+// KeywordSearchRequestV3 is the request body for a V3 keyword search.
+type KeywordSearchRequestV3 struct {
+	Keywords                   string   `json:"Keywords"`
+	RecordCount                int      `json:"RecordCount"`
+	RecordStartPosition        int      `json:"RecordStartPosition"`
+	SearchOptions              []string `json:"SearchOptions,omitempty"`
+	ExcludeMarketPlaceProducts bool     `json:"ExcludeMarketPlaceProducts,omitempty"`
+}
+
+// KeywordSearchResponseV3 is the response to a V3 keyword search.
+type KeywordSearchResponseV3 struct {
+	Products                       []ProductV3 `json:"Products"`
+	ProductsCount                  int         `json:"ProductsCount"`
+	ExactManufacturerProducts      []ProductV3 `json:"ExactManufacturerProducts"`
+	ExactManufacturerProductsCount int         `json:"ExactManufacturerProductsCount"`
+	ExactDigiKeyProduct            *ProductV3  `json:"ExactDigiKeyProduct"`
+	SearchLocaleUsed               Locale      `json:"SearchLocaleUsed"`
+}
+
+// KeywordSearchV3 searches for products using the V3 API, returning the V3
+// models.
+func (s *ProductsService) KeywordSearchV3(ctx context.Context, req KeywordSearchRequestV3, opts ...RequestOption) (*KeywordSearchResponseV3, error) {
+	resp := &KeywordSearchResponseV3{}
+	if err := s.client.postJSON(ctx, productsV3Path+"Keyword", req, resp, opts...); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ProductDetailsV3 returns the product with the given DigiKey or
+// manufacturer product number using the V3 API.
+func (s *ProductsService) ProductDetailsV3(ctx context.Context, partNumber string, opts ...RequestOption) (*ProductV3, error) {
+	resp := &ProductV3{}
+	if err := s.client.getJSON(ctx, productsV3Path+url.PathEscape(partNumber), nil, resp, opts...); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// keywordSearchV3 runs a V4 keyword request against the V3 API. Parametric,
+// manufacturer, category, and other ID filters have no V3 equivalent here
+// and are ignored.
+func (s *ProductsService) keywordSearchV3(ctx context.Context, req KeywordRequest, opts []RequestOption) (*KeywordResponse, error) {
+	v3 := KeywordSearchRequestV3{
+		Keywords:            req.Keywords,
+		RecordCount:         req.Limit,
+		RecordStartPosition: req.Offset,
+	}
+	if f := req.FilterOptionsRequest; f != nil {
+		v3.SearchOptions = f.SearchOptions
+		v3.ExcludeMarketPlaceProducts = f.MarketPlaceFilter == "ExcludeMarketPlace"
+	}
+	resp, err := s.KeywordSearchV3(ctx, v3, opts...)
+	if err != nil {
+		return nil, err
+	}
+	out := &KeywordResponse{
+		ProductsCount:    resp.ProductsCount,
+		SearchLocaleUsed: resp.SearchLocaleUsed,
+	}
+	for _, p := range resp.Products {
+		out.Products = append(out.Products, p.ToProduct())
+	}
+	for _, p := range resp.ExactManufacturerProducts {
+		out.ExactMatches = append(out.ExactMatches, p.ToProduct())
+	}
+	if p := resp.ExactDigiKeyProduct; p != nil {
+		out.ExactMatches = append(out.ExactMatches, p.ToProduct())
+	}
+	return out, nil
+}
+
+// ToProduct converts the V3 product to the V4 model, with its packaging as
+// the single product variation.
+func (p ProductV3) ToProduct() Product {
+	params := make([]Parameter, len(p.Parameters))
+	for i, pv := range p.Parameters {
+		params[i] = Parameter{
+			ParameterID:   pv.ParameterID,
+			ParameterText: pv.Parameter,
+			ValueID:       pv.ValueID,
+			ValueText:     pv.Value,
+		}
+	}
+	return Product{
+		Description: Description{
+			ProductDescription:  p.ProductDescription,
+			DetailedDescription: p.DetailedDescription,
+		},
+		Manufacturer:               Manufacturer{ID: atoiOrZero(p.Manufacturer.ValueID), Name: p.Manufacturer.Value},
+		ManufacturerProductNumber:  p.ManufacturerPartNumber,
+		UnitPrice:                  p.UnitPrice,
+		ProductURL:                 p.ProductURL,
+		DatasheetURL:               p.PrimaryDatasheet,
+		PhotoURL:                   p.PrimaryPhoto,
+		PrimaryVideoURL:            p.PrimaryVideo,
+		QuantityAvailable:          p.QuantityAvailable,
+		ProductStatus:              ProductStatus{Status: p.ProductStatus},
+		BackOrderNotAllowed:        p.BackOrderNotAllowed,
+		NormallyStocking:           !p.NonStock,
+		NCNR:                       p.IsNcnr,
+		Parameters:                 params,
+		Category:                   Category{CategoryID: atoiOrZero(p.Category.ValueID), Name: p.Category.Value},
+		ManufacturerLeadWeeks:      p.ManufacturerLeadWeeks,
+		ManufacturerPublicQuantity: p.ManufacturerPublicQuantity,
+		Series:                     IDName{ID: atoiOrZero(p.Series.ValueID), Name: p.Series.Value},
+		Classifications: Classifications{
+			RohsStatus:  p.RoHSStatus,
+			ReachStatus: p.ReachStatus,
+		},
+		ProductVariations: []ProductVariation{{
+			DigiKeyProductNumber:            p.DigiKeyPartNumber,
+			PackageType:                     IDName{ID: atoiOrZero(p.Packaging.ValueID), Name: p.Packaging.Value},
+			StandardPricing:                 p.StandardPricing,
+			MarketPlace:                     p.Marketplace,
+			QuantityAvailableForPackageType: p.QuantityAvailable,
+			MaxQuantityForDistribution:      p.MaxQuantityForDistribution,
+			MinimumOrderQuantity:            p.MinimumOrderQuantity,
+		}},
+	}
+}
+
+func atoiOrZero(s string) int {
+	n, _ := strconv.Atoi(s)
+	return n
+}
@@ -0,0 +1,221 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/apidepot/digikey/internal/testhooks"
+)
+
+// DefaultDailyQuota is the number of requests per day DigiKey allows an
+// application by default.
+const DefaultDailyQuota = 1000
+
+// ErrQuotaExceeded is returned when a request or reservation would exceed
+// the daily quota.
+var ErrQuotaExceeded = errors.New("daily request quota exceeded")
+
+// QuotaTracker tracks the daily request quota. It counts the requests made
+// by the clients using it and adopts the limit and remaining count reported
+// by DigiKey in the X-RateLimit-Limit and X-RateLimit-Remaining headers.
+//
+// Parts of the quota can be reserved ahead of time, so a large job can check
+// that it will be able to finish before starting. Reserved requests are
+// unavailable to other requests until they are used or released.
+type QuotaTracker struct {
+	mu       sync.Mutex
+	limit    int
+	used     int
+	reserved int
+	gen      int
+	resetAt  time.Time
+	loc      *time.Location
+	now      func() time.Time
+}
+
+// Reservation is a part of the daily quota set aside by Reserve. Requests
+// made with the UseReservation option draw from it.
+type Reservation struct {
+	q         *QuotaTracker
+	gen       int
+	remaining int
+}
+
+// NewQuotaTracker creates a tracker for the given daily limit, which resets
+// at midnight UTC.
+func NewQuotaTracker(dailyLimit int) *QuotaTracker {
+	q := &QuotaTracker{
+		limit: dailyLimit,
+		loc:   time.UTC,
+		now:   time.Now,
+	}
+	q.resetAt = q.nextReset()
+	return q
+}
+
+// WithQuotaTracker tracks the client's requests against the quota tracker.
+// Requests that would exceed the unreserved quota fail with
+// ErrQuotaExceeded without being sent.
+func WithQuotaTracker(q *QuotaTracker) ClientOption {
+	return func(client *Client) {
+		client.quota = q
+	}
+}
+
+// QuotaTracker returns the client's quota tracker, or nil if it has none.
+func (c *Client) QuotaTracker() *QuotaTracker {
+	return c.quota
+}
+
+// UseReservation draws the request from the reservation while it has
+// requests remaining.
+func UseReservation(r *Reservation) RequestOption {
+	return func(o *requestOptions) {
+		o.reservation = r
+	}
+}
+
+// Limit returns the daily request limit.
+func (q *QuotaTracker) Limit() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.rollover()
+	return q.limit
+}
+
+// Used returns the number of requests made since the last reset.
+func (q *QuotaTracker) Used() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.rollover()
+	return q.used
+}
+
+// Available returns the number of requests that are neither used nor
+// reserved.
+func (q *QuotaTracker) Available() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.rollover()
+	return q.available()
+}
+
+// ResetAt returns when the quota next resets.
+func (q *QuotaTracker) ResetAt() time.Time {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.rollover()
+	return q.resetAt
+}
+
+// Reserve sets aside n requests of today's quota, returning
+// ErrQuotaExceeded if fewer than n are available. Reservations lapse when
+// the quota resets.
+func (q *QuotaTracker) Reserve(n int) (*Reservation, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.rollover()
+	if n <= 0 || n > q.available() {
+		return nil, ErrQuotaExceeded
+	}
+	q.reserved += n
+	return &Reservation{q: q, gen: q.gen, remaining: n}, nil
+}
+
+// Remaining returns the number of reserved requests not yet used.
+func (r *Reservation) Remaining() int {
+	r.q.mu.Lock()
+	defer r.q.mu.Unlock()
+	r.q.rollover()
+	if r.gen != r.q.gen {
+		return 0
+	}
+	return r.remaining
+}
+
+// Release returns the unused requests of the reservation to the quota.
+func (r *Reservation) Release() {
+	r.q.mu.Lock()
+	defer r.q.mu.Unlock()
+	r.q.rollover()
+	if r.gen == r.q.gen {
+		r.q.reserved -= r.remaining
+	}
+	r.remaining = 0
+}
+
+// acquire counts a request against the reservation, if it has requests
+// remaining, or else against the unreserved quota.
+func (q *QuotaTracker) acquire(r *Reservation) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.rollover()
+	if r != nil && r.q == q && r.gen == q.gen && r.remaining > 0 {
+		r.remaining--
+		q.reserved--
+		q.used++
+		return nil
+	}
+	if q.available() <= 0 {
+		return ErrQuotaExceeded
+	}
+	q.used++
+	return nil
+}
+
+// update adopts the limit and remaining count reported in the response
+// headers.
+func (q *QuotaTracker) update(h http.Header) {
+	limit, err := strconv.Atoi(h.Get("X-RateLimit-Limit"))
+	if err != nil {
+		return
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.rollover()
+	q.limit = limit
+	if remaining, err := strconv.Atoi(h.Get("X-RateLimit-Remaining")); err == nil {
+		if used := limit - remaining; used > q.used {
+			q.used = used
+		}
+	}
+}
+
+func (q *QuotaTracker) available() int {
+	return max(q.limit-q.used-q.reserved, 0)
+}
+
+// rollover resets the counts and lapses reservations once the reset time
+// has passed.
+func (q *QuotaTracker) rollover() {
+	if q.now().Before(q.resetAt) {
+		return
+	}
+	q.used = 0
+	q.reserved = 0
+	q.gen++
+	q.resetAt = q.nextReset()
+}
+
+func init() {
+	testhooks.SetQuotaClock = func(tracker any, now func() time.Time) {
+		q := tracker.(*QuotaTracker)
+		q.mu.Lock()
+		defer q.mu.Unlock()
+		q.now = now
+		q.resetAt = q.nextReset()
+	}
+}
+
+func (q *QuotaTracker) nextReset() time.Time {
+	y, m, d := q.now().In(q.loc).Date()
+	return time.Date(y, m, d+1, 0, 0, 0, 0, q.loc)
+}
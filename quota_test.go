@@ -0,0 +1,126 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// newTestQuota returns a tracker of the limit whose clock starts at 22:00
+// UTC and is advanced by the returned function.
+func newTestQuota(limit int) (*QuotaTracker, func(time.Duration)) {
+	now := time.Date(2025, 6, 1, 22, 0, 0, 0, time.UTC)
+	q := NewQuotaTracker(limit)
+	q.now = func() time.Time { return now }
+	q.resetAt = q.nextReset()
+	return q, func(d time.Duration) { now = now.Add(d) }
+}
+
+func TestQuotaReserve(t *testing.T) {
+	q, _ := newTestQuota(10)
+	r, err := q.Reserve(4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if q.Available() != 6 || r.Remaining() != 4 {
+		t.Errorf("after Reserve(4): Available() = %d, Remaining() = %d, want 6 and 4", q.Available(), r.Remaining())
+	}
+	if _, err := q.Reserve(7); !errors.Is(err, ErrQuotaExceeded) {
+		t.Errorf("Reserve(7) with 6 available = %v, want ErrQuotaExceeded", err)
+	}
+	if _, err := q.Reserve(0); !errors.Is(err, ErrQuotaExceeded) {
+		t.Errorf("Reserve(0) = %v, want ErrQuotaExceeded", err)
+	}
+
+	// Requests draw from the reservation, then from the unreserved quota.
+	for range 5 {
+		if err := q.acquire(r); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if q.Used() != 5 || q.Available() != 5 || r.Remaining() != 0 {
+		t.Errorf("after 5 requests: Used() = %d, Available() = %d, Remaining() = %d, want 5, 5, 0", q.Used(), q.Available(), r.Remaining())
+	}
+
+	// Unreserved requests cannot use reserved requests.
+	r2, _ := q.Reserve(5)
+	if err := q.acquire(nil); !errors.Is(err, ErrQuotaExceeded) {
+		t.Errorf("unreserved request with the rest reserved = %v, want ErrQuotaExceeded", err)
+	}
+	q.acquire(r2)
+	r2.Release()
+	r2.Release()
+	if q.Available() != 4 || r2.Remaining() != 0 {
+		t.Errorf("after Release: Available() = %d, Remaining() = %d, want 4 and 0", q.Available(), r2.Remaining())
+	}
+}
+
+func TestQuotaReset(t *testing.T) {
+	q, advance := newTestQuota(10)
+	want := time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)
+	if got := q.ResetAt(); !got.Equal(want) {
+		t.Errorf("ResetAt() = %s, want %s", got, want)
+	}
+	r, _ := q.Reserve(5)
+	for range 3 {
+		q.acquire(nil)
+	}
+
+	advance(2 * time.Hour)
+	if q.Used() != 0 || q.Available() != 10 {
+		t.Errorf("after the reset: Used() = %d, Available() = %d, want 0 and 10", q.Used(), q.Available())
+	}
+	// Reservations lapse, and releasing one does not touch the new day.
+	if r.Remaining() != 0 {
+		t.Errorf("Remaining() of a lapsed reservation = %d, want 0", r.Remaining())
+	}
+	r.Release()
+	q.acquire(r)
+	if q.Used() != 1 || q.Available() != 9 {
+		t.Errorf("request with a lapsed reservation: Used() = %d, Available() = %d, want 1 and 9", q.Used(), q.Available())
+	}
+	if got := q.ResetAt(); !got.Equal(want.AddDate(0, 0, 1)) {
+		t.Errorf("ResetAt() after the reset = %s, want %s", got, want.AddDate(0, 0, 1))
+	}
+}
+
+func TestQuotaUpdate(t *testing.T) {
+	q, _ := newTestQuota(DefaultDailyQuota)
+	q.acquire(nil)
+	q.update(http.Header{"X-Ratelimit-Limit": {"500"}, "X-Ratelimit-Remaining": {"480"}})
+	if q.Limit() != 500 || q.Used() != 20 {
+		t.Errorf("after update: Limit() = %d, Used() = %d, want 500 and 20", q.Limit(), q.Used())
+	}
+	// A remaining count lagging behind the requests counted is ignored.
+	q.update(http.Header{"X-Ratelimit-Limit": {"500"}, "X-Ratelimit-Remaining": {"490"}})
+	if q.Used() != 20 {
+		t.Errorf("Used() after a stale update = %d, want 20", q.Used())
+	}
+	q.update(http.Header{})
+	if q.Limit() != 500 {
+		t.Errorf("Limit() after a response without quota headers = %d, want 500", q.Limit())
+	}
+}
+
+func TestQuotaClient(t *testing.T) {
+	q, _ := newTestQuota(2)
+	var calls int
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) { calls++ }, WithQuotaTracker(q))
+	u, _ := c.url("products/v4/search/manufacturers", nil)
+	ctx := context.Background()
+	for range 2 {
+		if _, err := c.getBytes(ctx, u.String()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := c.getBytes(ctx, u.String()); !errors.Is(err, ErrQuotaExceeded) || calls != 2 {
+		t.Errorf("request beyond the quota = %v after %d requests, want ErrQuotaExceeded without a request", err, calls)
+	}
+}
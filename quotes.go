@@ -0,0 +1,168 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+)
+
+const quotesPath = "quoting/v4/quotes"
+
+// QuotesService provides access to the Quoting V4 API. The client must use a
+// token from the three-legged OAuth2 flow; see WithAccessToken. Quotes are
+// read from the API every time, bypassing the client's cache.
+type QuotesService struct {
+	client *Client
+}
+
+// QuoteRequest is a request to quote the listed parts.
+type QuoteRequest struct {
+	Name  string
+	Lines []QuoteRequestLine
+}
+
+// QuoteRequestLine is a part to quote at one or more quantities.
+type QuoteRequestLine struct {
+	PartNumber        string `json:"ProductNumber"`
+	Quantities        []int  `json:"Quantities"`
+	CustomerReference string `json:"CustomerReference,omitempty"`
+}
+
+// Quote is a DigiKey quote and its priced lines.
+type Quote struct {
+	QuoteID        int         `json:"QuoteId"`
+	QuoteName      string      `json:"QuoteName"`
+	CustomerID     int         `json:"CustomerId"`
+	DateCreated    Time        `json:"DateCreated"`
+	ExpirationDate Time        `json:"ExpirationDate"`
+	Currency       string      `json:"Currency"`
+	Lines          []QuoteLine `json:"Lines,omitempty"`
+}
+
+// QuoteLine is a quoted part and its price at each requested quantity.
+type QuoteLine struct {
+	DetailID                  int          `json:"DetailId"`
+	DigiKeyProductNumber      string       `json:"DigiKeyProductNumber"`
+	ManufacturerProductNumber string       `json:"ManufacturerProductNumber"`
+	ManufacturerName          string       `json:"ManufacturerName"`
+	Description               string       `json:"Description"`
+	CustomerReference         string       `json:"CustomerReference"`
+	MinimumOrderQuantity      int          `json:"MinimumOrderQuantity"`
+	QuantityAvailable         int          `json:"QuantityAvailable"`
+	Pricing                   []QuotePrice `json:"Quantities"`
+}
+
+// QuotePrice is the quoted price of a line at a quantity.
+type QuotePrice struct {
+	Quantity      int   `json:"Quantity"`
+	UnitPrice     Money `json:"UnitPrice"`
+	ExtendedPrice Money `json:"ExtendedPrice"`
+}
+
+// quoteDetailsResponse is the response to a quote details request.
+type quoteDetailsResponse struct {
+	QuoteDetails []QuoteLine `json:"QuoteDetails"`
+}
+
+// Create creates a quote for the requested parts and returns it with its
+// priced lines.
+func (s *QuotesService) Create(ctx context.Context, req QuoteRequest, opts ...RequestOption) (*Quote, error) {
+	created := struct {
+		QuoteID int `json:"QuoteId"`
+	}{}
+	body := struct {
+		QuoteName string `json:"QuoteName"`
+	}{req.Name}
+	if err := s.client.sendJSON(ctx, "POST", quotesPath, body, &created, opts...); err != nil {
+		return nil, err
+	}
+	if len(req.Lines) > 0 {
+		endpoint := quotePath(created.QuoteID) + "/details"
+		if err := s.client.sendJSON(ctx, "POST", endpoint, req.Lines, nil, opts...); err != nil {
+			return nil, fmt.Errorf("error adding parts to quote %d: %w", created.QuoteID, err)
+		}
+	}
+	return s.Get(ctx, created.QuoteID, opts...)
+}
+
+// Get returns the quote with the given ID and its priced lines.
+func (s *QuotesService) Get(ctx context.Context, quoteID int, opts ...RequestOption) (*Quote, error) {
+	quote := &Quote{}
+	if err := s.client.getCustomerJSON(ctx, quotePath(quoteID), nil, quote, opts...); err != nil {
+		return nil, err
+	}
+	details := quoteDetailsResponse{}
+	if err := s.client.getCustomerJSON(ctx, quotePath(quoteID)+"/details", nil, &details, opts...); err != nil {
+		return nil, err
+	}
+	quote.Lines = details.QuoteDetails
+	return quote, nil
+}
+
+// quotesResponse is the response to a quotes request.
+type quotesResponse struct {
+	Quotes      []Quote `json:"Quotes"`
+	TotalQuotes int     `json:"TotalQuotes"`
+}
+
+// quotesPageSize is the number of quotes List requests at a time.
+const quotesPageSize = 100
+
+// List returns every quote of the authenticated customer, without lines.
+func (s *QuotesService) List(ctx context.Context, opts ...RequestOption) ([]Quote, error) {
+	var quotes []Quote
+	for {
+		params := map[string]string{
+			"offset": strconv.Itoa(len(quotes)),
+			"limit":  strconv.Itoa(quotesPageSize),
+		}
+		resp := quotesResponse{}
+		if err := s.client.getCustomerJSON(ctx, quotesPath, params, &resp, opts...); err != nil {
+			return nil, err
+		}
+		quotes = append(quotes, resp.Quotes...)
+		if len(resp.Quotes) < quotesPageSize || len(quotes) >= resp.TotalQuotes {
+			return quotes, nil
+		}
+	}
+}
+
+// Expired reports whether the quote has expired.
+func (q Quote) Expired() bool {
+	return !q.ExpirationDate.IsZero() && time.Now().After(q.ExpirationDate.Time)
+}
+
+// ExpiresWithin reports whether the quote has not yet expired but will
+// within the given number of days.
+func (q Quote) ExpiresWithin(days int) bool {
+	if q.ExpirationDate.IsZero() || q.Expired() {
+		return false
+	}
+	return q.ExpirationDate.Before(time.Now().AddDate(0, 0, days))
+}
+
+// ExpiringQuotes returns the quotes that have not yet expired but will
+// within the given number of days, soonest first.
+func ExpiringQuotes(quotes []Quote, days int) []Quote {
+	var expiring []Quote
+	for _, q := range quotes {
+		if q.ExpiresWithin(days) {
+			expiring = append(expiring, q)
+		}
+	}
+	slices.SortStableFunc(expiring, func(a, b Quote) int {
+		return a.ExpirationDate.Compare(b.ExpirationDate.Time)
+	})
+	return expiring
+}
+
+func quotePath(quoteID int) string {
+	return quotesPath + "/" + strconv.Itoa(quoteID)
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// quoteServer creates and serves quotes, keeping them in memory.
+type quoteServer struct {
+	mu     sync.Mutex
+	quotes []Quote
+}
+
+func (s *quoteServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rest := strings.TrimPrefix(r.URL.Path, "/"+quotesPath)
+	switch {
+	case rest == "" && r.Method == http.MethodPost:
+		var body struct{ QuoteName string }
+		json.NewDecoder(r.Body).Decode(&body)
+		q := Quote{QuoteID: len(s.quotes) + 1, QuoteName: body.QuoteName, Currency: "USD"}
+		s.quotes = append(s.quotes, q)
+		json.NewEncoder(w).Encode(map[string]int{"QuoteId": q.QuoteID})
+	case rest == "" && r.Method == http.MethodGet:
+		json.NewEncoder(w).Encode(quotesResponse{Quotes: s.quotes, TotalQuotes: len(s.quotes)})
+	default:
+		id, details := strings.CutSuffix(strings.TrimPrefix(rest, "/"), "/details")
+		n, err := strconv.Atoi(id)
+		if err != nil || n < 1 || n > len(s.quotes) {
+			http.NotFound(w, r)
+			return
+		}
+		q := &s.quotes[n-1]
+		switch {
+		case details && r.Method == http.MethodPost:
+			var lines []QuoteRequestLine
+			json.NewDecoder(r.Body).Decode(&lines)
+			for _, l := range lines {
+				q.Lines = append(q.Lines, QuoteLine{DigiKeyProductNumber: l.PartNumber})
+			}
+		case details:
+			json.NewEncoder(w).Encode(quoteDetailsResponse{QuoteDetails: q.Lines})
+		default:
+			json.NewEncoder(w).Encode(Quote{QuoteID: q.QuoteID, QuoteName: q.QuoteName, Currency: q.Currency})
+		}
+	}
+}
+
+func TestQuotesBypassCache(t *testing.T) {
+	c := newTestClient(t, (&quoteServer{}).ServeHTTP, WithCache(NewMemoryCache(), time.Hour))
+	ctx := context.Background()
+
+	if quotes, err := c.Quotes.List(ctx); err != nil || len(quotes) != 0 {
+		t.Fatalf("List() = %v, %v, want no quotes", quotes, err)
+	}
+	q, err := c.Quotes.Create(ctx, QuoteRequest{
+		Name:  "rev B",
+		Lines: []QuoteRequestLine{{PartNumber: "296-1395-5-ND", Quantities: []int{100}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(q.Lines) != 1 || q.Lines[0].DigiKeyProductNumber != "296-1395-5-ND" {
+		t.Errorf("Create() lines = %+v, want the requested part", q.Lines)
+	}
+	quotes, err := c.Quotes.List(ctx)
+	if err != nil || len(quotes) != 1 || quotes[0].QuoteID != q.QuoteID {
+		t.Errorf("List() after Create = %+v, %v, want the new quote", quotes, err)
+	}
+	if stats := c.CacheStats(); stats.Hits != 0 || stats.Misses != 0 || stats.Stores != 0 {
+		t.Errorf("CacheStats() = %+v, want the cache untouched", stats)
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import (
+	"context"
+	"strconv"
+)
+
+// RecommendedProduct is a product DigiKey recommends alongside another,
+// such as one frequently bought with it.
+type RecommendedProduct struct {
+	DigiKeyProductNumber      string `json:"DigiKeyProductNumber"`
+	ManufacturerProductNumber string `json:"ManufacturerProductNumber"`
+	ManufacturerName          string `json:"ManufacturerName"`
+	PrimaryPhoto              string `json:"PrimaryPhoto"`
+	ProductDescription        string `json:"ProductDescription"`
+	QuantityAvailable         int    `json:"QuantityAvailable"`
+	UnitPrice                 Money  `json:"UnitPrice"`
+	ProductURL                string `json:"ProductUrl"`
+}
+
+// recommendation holds the recommendations for a single product number.
+type recommendation struct {
+	ProductNumber       string               `json:"ProductNumber"`
+	RecommendedProducts []RecommendedProduct `json:"RecommendedProducts"`
+}
+
+// recommendedProductsResponse is the response to a recommended products
+// request.
+type recommendedProductsResponse struct {
+	Recommendations []recommendation `json:"Recommendations"`
+}
+
+// RecommendedProducts returns up to limit products DigiKey recommends for the
+// product with the given DigiKey product number. A zero limit uses DigiKey's
+// default.
+func (s *ProductsService) RecommendedProducts(ctx context.Context, partNumber string, limit int, opts ...RequestOption) ([]RecommendedProduct, error) {
+	var params map[string]string
+	if limit > 0 {
+		params = map[string]string{"limit": strconv.Itoa(limit)}
+	}
+	resp := recommendedProductsResponse{}
+	if err := s.client.getJSON(ctx, productPath(partNumber, "recommendedproducts"), params, &resp, opts...); err != nil {
+		return nil, err
+	}
+	var products []RecommendedProduct
+	for _, r := range resp.Recommendations {
+		products = append(products, r.RecommendedProducts...)
+	}
+	return products, nil
+}
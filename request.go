@@ -0,0 +1,118 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import (
+	"net/http"
+	"time"
+)
+
+// Headers of the DigiKey API selecting the locale of a response.
+const (
+	LocaleSiteHeader     = "X-DIGIKEY-Locale-Site"
+	LocaleLanguageHeader = "X-DIGIKEY-Locale-Language"
+	LocaleCurrencyHeader = "X-DIGIKEY-Locale-Currency"
+)
+
+// RequestOption applies an option to a single request.
+type RequestOption func(*requestOptions)
+
+// requestOptions holds the options of a single request.
+type requestOptions struct {
+	cacheMode   cacheMode
+	reservation *Reservation
+	header      http.Header
+	timeout     time.Duration
+	metadata    *ResponseMetadata
+	apiVersion  APIVersion
+	retryUnsafe bool
+}
+
+// cacheMode controls how a request uses the client's cache.
+type cacheMode int
+
+const (
+	cacheDefault cacheMode = iota
+	cacheOnly
+	cacheBypass
+	cacheRefresh
+)
+
+func newRequestOptions(opts []RequestOption) *requestOptions {
+	o := &requestOptions{header: make(http.Header)}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// CacheOnly answers the request from the cache, returning ErrCacheMiss
+// instead of calling the API when the response is not cached.
+func CacheOnly() RequestOption {
+	return func(o *requestOptions) {
+		o.cacheMode = cacheOnly
+	}
+}
+
+// BypassCache calls the API without reading or updating the cache.
+func BypassCache() RequestOption {
+	return func(o *requestOptions) {
+		o.cacheMode = cacheBypass
+	}
+}
+
+// ForceRefresh calls the API without reading the cache, then stores the
+// fresh response in the cache.
+func ForceRefresh() RequestOption {
+	return func(o *requestOptions) {
+		o.cacheMode = cacheRefresh
+	}
+}
+
+// AllowRetry lets Do retry a request whose method is not idempotent, such
+// as a POST search, which the caller knows can safely be sent again. Without
+// it, only GET, HEAD, OPTIONS, PUT, and DELETE requests are retried, so a
+// request creating a quote or submitting an order is never duplicated.
+func AllowRetry() RequestOption {
+	return func(o *requestOptions) {
+		o.retryUnsafe = true
+	}
+}
+
+// WithRequestHeader sets a header of the request, replacing any value set
+// by the client. The authorization and client ID headers cannot be
+// overridden.
+func WithRequestHeader(key, value string) RequestOption {
+	return func(o *requestOptions) {
+		o.header.Set(key, value)
+	}
+}
+
+// WithRequestTimeout limits the time the request may take, including
+// waiting for the rate limiter, overriding the client's timeout if shorter.
+func WithRequestTimeout(d time.Duration) RequestOption {
+	return func(o *requestOptions) {
+		o.timeout = d
+	}
+}
+
+// WithRequestLocale requests the response for a locale, overriding the
+// account's default. Empty values are left to the default, e.g.
+//
+//	WithRequestLocale(Locale{Site: "DE", Language: "de", Currency: "EUR"})
+func WithRequestLocale(l Locale) RequestOption {
+	return func(o *requestOptions) {
+		for k, v := range map[string]string{
+			LocaleSiteHeader:     l.Site,
+			LocaleLanguageHeader: l.Language,
+			LocaleCurrencyHeader: l.Currency,
+		} {
+			if v != "" {
+				o.header.Set(k, v)
+			}
+		}
+	}
+}
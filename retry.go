@@ -0,0 +1,150 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Defaults of the retries of Do, unless changed with WithRetries.
+const (
+	DefaultMaxRetries      = 3
+	DefaultRetryBackoff    = 500 * time.Millisecond
+	DefaultRetryBackoffMax = 30 * time.Second
+)
+
+// WithRetries sets how Do retries requests that are throttled (429 Too Many
+// Requests) or fail with a server error (5xx): up to n times, after the
+// delay the response's Retry-After header asks for, or else after a backoff
+// that doubles with each retry from base up to max, with jitter. A response
+// asking for a longer wait than max is returned rather than retried. Zero
+// retries disables them.
+func WithRetries(n int, base, max time.Duration) ClientOption {
+	return func(client *Client) {
+		client.maxRetries = n
+		client.retryBackoff = base
+		client.retryBackoffMax = max
+	}
+}
+
+// shouldRetry reports whether a response with the status code may succeed
+// if the request is sent again.
+func shouldRetry(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode >= 500
+}
+
+// replayable reports whether the request may be sent again: a request with
+// an idempotent method, or with another method if the caller allowed it
+// with AllowRetry, and without a body or with a body GetBody can recreate.
+// Requests that http.NewRequest creates from a bytes.Buffer, bytes.Reader,
+// or strings.Reader have GetBody set.
+func replayable(req *http.Request, o *requestOptions) bool {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+	switch req.Method {
+	case "", http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return o.retryUnsafe
+}
+
+// retryDelay returns the delay before retrying a request after the
+// response, or false if the response asks for a longer delay than the
+// backoff allows.
+func (c *Client) retryDelay(resp *http.Response, retry int) (time.Duration, bool) {
+	if d, ok := parseRetryAfter(resp.Header, time.Now()); ok {
+		return d, d <= c.retryBackoffMax
+	}
+	d := c.retryBackoff
+	for i := 1; i < retry && d < c.retryBackoffMax; i++ {
+		d *= 2
+	}
+	d = min(d, c.retryBackoffMax)
+	if d <= 0 {
+		return 0, true
+	}
+	return d/2 + rand.N(d/2+1), true
+}
+
+// DefaultRetryAfter is the wait RetryAfter reports for a throttled request
+// whose response did not say how long to wait.
+const DefaultRetryAfter = 30 * time.Second
+
+// RetryAfter reports whether the error shows the API throttled the request
+// with 429 Too Many Requests, and if so, how long to wait before trying
+// again: the delay the response's Retry-After header asked for, or else
+// DefaultRetryAfter. Bulk tools, such as the crawler and the scheduler, use
+// it to back off alike.
+func RetryAfter(err error) (time.Duration, bool) {
+	var apiErr Error
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusTooManyRequests {
+		return 0, false
+	}
+	if apiErr.RetryAfter > 0 {
+		return apiErr.RetryAfter, true
+	}
+	return DefaultRetryAfter, true
+}
+
+// parseRetryAfter returns the delay the Retry-After header asks for, given
+// as seconds or as an HTTP date, or false if it has none.
+func parseRetryAfter(h http.Header, now time.Time) (time.Duration, bool) {
+	v := h.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+	if n, err := strconv.Atoi(v); err == nil && n >= 0 {
+		return time.Duration(n) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return max(t.Sub(now), 0), true
+	}
+	return 0, false
+}
+
+// doWithRetries sends the request, retrying it under the client's retry
+// policy if it is replayable.
+func (c *Client) doWithRetries(ctx context.Context, req *http.Request, o *requestOptions) (*http.Response, error) {
+	canRetry := replayable(req, o)
+	attempt := req
+	for retry := 1; ; retry++ {
+		resp, err := c.roundTrip(ctx, attempt, o)
+		if err != nil || !canRetry || retry > c.maxRetries || !shouldRetry(resp.StatusCode) {
+			return resp, err
+		}
+		delay, ok := c.retryDelay(resp, retry)
+		if deadline, has := ctx.Deadline(); has && time.Now().Add(delay).After(deadline) {
+			ok = false
+		}
+		if !ok {
+			return resp, nil
+		}
+		// Drain a little of the body so the connection can be reused.
+		io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+		resp.Body.Close()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		}
+		attempt = req.Clone(ctx)
+		if req.GetBody != nil {
+			if attempt.Body, err = req.GetBody(); err != nil {
+				return nil, err
+			}
+		}
+	}
+}
@@ -0,0 +1,435 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+// Package schedule runs large batches of DigiKey requests, such as syncing
+// a catalog, over as many days as the daily quota requires.
+//
+// A Scheduler holds a queue of jobs, each a product detail lookup or a
+// keyword search paged through to the end. It spreads its requests evenly
+// over the time left until the quota resets, using no more than a share of
+// the quota so interactive use keeps working, and waits for the reset once
+// the share is used. The queue and the progress of each job are saved to a
+// state file after every request, so a scheduler opened again on the same
+// file after a restart resumes where it stopped.
+package schedule
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/apidepot/digikey"
+)
+
+// DefaultQuotaShare is the share of the daily quota a scheduler uses,
+// unless changed with WithQuotaShare.
+const DefaultQuotaShare = 0.8
+
+// DefaultMaxAttempts is the number of times a job is tried before it is
+// marked failed, unless changed with WithMaxAttempts.
+const DefaultMaxAttempts = 3
+
+// Kind is the kind of request a job makes.
+type Kind string
+
+// Job kinds.
+const (
+	// KindDetails looks up the details of a product.
+	KindDetails Kind = "details"
+	// KindSearch pages through the results of a keyword search.
+	KindSearch Kind = "search"
+)
+
+// Job is a unit of work for a scheduler.
+type Job struct {
+	// ID identifies the job in the queue; adding a job with the ID of a
+	// queued job has no effect.
+	ID   string `json:"id"`
+	Kind Kind   `json:"kind"`
+	// PartNumber is the DigiKey or manufacturer product number of a
+	// KindDetails job.
+	PartNumber string `json:"partNumber,omitempty"`
+	// Request is the search of a KindSearch job. Its limit is the page
+	// size, or digikey.MaxSearchLimit if zero, and its offset is where the
+	// search starts.
+	Request *digikey.KeywordRequest `json:"request,omitempty"`
+}
+
+// DetailsJob returns a job looking up the details of the product with the
+// given DigiKey or manufacturer product number.
+func DetailsJob(partNumber string) Job {
+	return Job{ID: "details/" + partNumber, Kind: KindDetails, PartNumber: partNumber}
+}
+
+// SearchJob returns a job paging through every result of the search.
+func SearchJob(id string, req digikey.KeywordRequest) Job {
+	return Job{ID: id, Kind: KindSearch, Request: &req}
+}
+
+// Status is the state of a job.
+type Status string
+
+// Job statuses.
+const (
+	StatusPending Status = "pending"
+	StatusDone    Status = "done"
+	StatusFailed  Status = "failed"
+)
+
+// JobState is a job and its progress.
+type JobState struct {
+	Job
+	Status Status `json:"status"`
+	// Offset and Total are the offset of the next page of a search and the
+	// number of results DigiKey last reported.
+	Offset int `json:"offset,omitempty"`
+	Total  int `json:"total,omitempty"`
+	// Attempts is the number of failed attempts at the job's current
+	// request, and Err the error of the last one.
+	Attempts  int       `json:"attempts,omitempty"`
+	Err       string    `json:"error,omitempty"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// Handler receives the products of a job: the product of a KindDetails job,
+// or a page of results of a KindSearch job. Progress is saved only after
+// the handler returns, so after a restart it may receive a page again. An
+// error from the handler stops Run.
+type Handler func(ctx context.Context, job Job, products []digikey.Product) error
+
+// Scheduler runs queued jobs within the daily quota.
+type Scheduler struct {
+	client      *digikey.Client
+	path        string
+	handler     Handler
+	share       float64
+	maxAttempts int
+	requestOpts []digikey.RequestOption
+	// last is when Run last made a request.
+	last time.Time
+	// now and wait read the clock and wait on it, and are replaced by
+	// tests.
+	now  func() time.Time
+	wait func(ctx context.Context, d time.Duration) error
+
+	mu   sync.Mutex
+	jobs []*JobState
+}
+
+// Option applies an option to a scheduler.
+type Option func(*Scheduler)
+
+// WithQuotaShare sets the share of the daily quota, between 0 and 1, the
+// scheduler may use, leaving the rest to other requests.
+func WithQuotaShare(share float64) Option {
+	return func(s *Scheduler) {
+		s.share = min(max(share, 0), 1)
+	}
+}
+
+// WithMaxAttempts sets the number of times a request is tried before its
+// job is marked failed. Throttled requests are not counted.
+func WithMaxAttempts(n int) Option {
+	return func(s *Scheduler) {
+		s.maxAttempts = max(n, 1)
+	}
+}
+
+// WithRequestOptions sets request options applied to every request.
+func WithRequestOptions(opts ...digikey.RequestOption) Option {
+	return func(s *Scheduler) {
+		s.requestOpts = append(s.requestOpts, opts...)
+	}
+}
+
+// Open returns a scheduler that makes requests using the client, passes
+// the products to the handler, and saves its queue to the state file at
+// path, resuming the queue saved there, if any.
+//
+// Requests are paced by the client's quota tracker, which should be
+// configured with digikey.WithQuotaTracker; without one, requests are only
+// subject to the client's rate limiter.
+func Open(c *digikey.Client, path string, handler Handler, opts ...Option) (*Scheduler, error) {
+	s := &Scheduler{
+		client:      c,
+		path:        path,
+		handler:     handler,
+		share:       DefaultQuotaShare,
+		maxAttempts: DefaultMaxAttempts,
+		now:         time.Now,
+		wait:        sleep,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading schedule: %w", err)
+	}
+	if err := json.Unmarshal(data, &s.jobs); err != nil {
+		return nil, fmt.Errorf("error reading schedule: %w", err)
+	}
+	return s, nil
+}
+
+// Add queues the jobs, skipping those whose ID is already queued, and
+// saves the queue. The manufacturer names and category subtrees of search
+// requests are resolved first, since they cannot be saved; this may make
+// requests, which are not paced.
+func (s *Scheduler) Add(ctx context.Context, jobs ...Job) error {
+	jobs = slices.Clone(jobs)
+	for i, job := range jobs {
+		switch {
+		case job.Kind == KindDetails && job.PartNumber != "":
+		case job.Kind == KindSearch && job.Request != nil:
+			req, err := s.client.Products.Resolve(ctx, *job.Request, s.requestOpts...)
+			if err != nil {
+				return fmt.Errorf("error adding %s: %w", job.ID, err)
+			}
+			jobs[i].Request = &req
+		default:
+			return fmt.Errorf("invalid %s job %q", job.Kind, job.ID)
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	queued := make(map[string]bool, len(s.jobs))
+	for _, js := range s.jobs {
+		queued[js.ID] = true
+	}
+	for _, job := range jobs {
+		if queued[job.ID] {
+			continue
+		}
+		queued[job.ID] = true
+		js := &JobState{Job: job, Status: StatusPending, UpdatedAt: s.now()}
+		if job.Request != nil {
+			js.Offset = job.Request.Offset
+		}
+		s.jobs = append(s.jobs, js)
+	}
+	return s.save()
+}
+
+// Jobs returns the state of every queued job, in the order they were added.
+func (s *Scheduler) Jobs() []JobState {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	jobs := make([]JobState, len(s.jobs))
+	for i, js := range s.jobs {
+		jobs[i] = *js
+	}
+	return jobs
+}
+
+// Pending returns the number of jobs neither done nor failed.
+func (s *Scheduler) Pending() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n := 0
+	for _, js := range s.jobs {
+		if js.Status == StatusPending {
+			n++
+		}
+	}
+	return n
+}
+
+// Run runs the pending jobs, one request at a time, until every job is
+// done or failed, waiting for the quota to reset as often as needed. It
+// returns early if the context is cancelled, the handler fails, or the
+// state cannot be saved; the queue can be resumed by calling Run again,
+// also after opening the state file anew. Run must not be called again
+// until it returns.
+func (s *Scheduler) Run(ctx context.Context) error {
+	for {
+		js := s.next()
+		if js == nil {
+			return nil
+		}
+		if err := s.pace(ctx); err != nil {
+			return err
+		}
+		if err := s.step(ctx, js); err != nil {
+			return err
+		}
+	}
+}
+
+// next returns the first pending job, or nil if there is none.
+func (s *Scheduler) next() *JobState {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, js := range s.jobs {
+		if js.Status == StatusPending {
+			return js
+		}
+	}
+	return nil
+}
+
+// step makes the next request of the job, passes its products to the
+// handler, and saves the job's progress.
+func (s *Scheduler) step(ctx context.Context, js *JobState) error {
+	opts := s.requestOpts
+	s.last = s.now()
+
+	var products []digikey.Product
+	var resp *digikey.KeywordResponse
+	var err error
+	switch js.Kind {
+	case KindDetails:
+		var p *digikey.Product
+		if p, err = s.client.Products.ProductDetails(ctx, js.PartNumber, opts...); err == nil {
+			products = []digikey.Product{*p}
+		}
+	case KindSearch:
+		if resp, err = s.search(ctx, js, opts); err == nil {
+			products = resp.Products
+		}
+	}
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if wait, ok := s.throttled(err); ok {
+			return s.wait(ctx, wait)
+		}
+		s.fail(js, err)
+		return s.saveLocked()
+	}
+
+	if err := s.handler(ctx, js.Job, products); err != nil {
+		return fmt.Errorf("error handling %s: %w", js.ID, err)
+	}
+	s.mu.Lock()
+	js.Attempts, js.Err = 0, ""
+	js.UpdatedAt = s.now()
+	if resp != nil {
+		js.Offset += len(resp.Products)
+		js.Total = resp.ProductsCount
+	}
+	if resp == nil || len(resp.Products) == 0 || js.Offset >= js.Total {
+		js.Status = StatusDone
+	}
+	s.mu.Unlock()
+	return s.saveLocked()
+}
+
+// search requests the next page of the job's search.
+func (s *Scheduler) search(ctx context.Context, js *JobState, opts []digikey.RequestOption) (*digikey.KeywordResponse, error) {
+	req := *js.Request
+	if req.Limit == 0 {
+		req.Limit = digikey.MaxSearchLimit
+	}
+	req.Offset = js.Offset
+	return s.client.Products.KeywordSearch(ctx, req, opts...)
+}
+
+// fail records a failed attempt at the job, marking the job failed if the
+// error is permanent or the attempts are used up.
+func (s *Scheduler) fail(js *JobState, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	js.Attempts++
+	js.Err = err.Error()
+	js.UpdatedAt = s.now()
+	var apiErr digikey.Error
+	permanent := errors.As(err, &apiErr) && apiErr.StatusCode >= 400 && apiErr.StatusCode < 500
+	if permanent || js.Attempts >= s.maxAttempts {
+		js.Status = StatusFailed
+	}
+}
+
+// throttled reports whether the error shows the quota is exhausted or the
+// API is throttling requests, and if so, how long to wait.
+func (s *Scheduler) throttled(err error) (time.Duration, bool) {
+	if errors.Is(err, digikey.ErrQuotaExceeded) {
+		if q := s.client.QuotaTracker(); q != nil {
+			return q.ResetAt().Sub(s.now()), true
+		}
+		return digikey.DefaultRetryAfter, true
+	}
+	return digikey.RetryAfter(err)
+}
+
+// pace waits until the next request may be made: at once if the scheduler
+// has not made one yet, otherwise after its share of the quota left today
+// is spread evenly over the time until the quota resets. Once the share is
+// used, it waits for the reset.
+func (s *Scheduler) pace(ctx context.Context) error {
+	q := s.client.QuotaTracker()
+	if q == nil {
+		return nil
+	}
+	for {
+		reset := q.ResetAt()
+		budget := int(float64(q.Limit())*s.share) - q.Used()
+		if budget > 0 && q.Available() > 0 {
+			interval := reset.Sub(s.now()) / time.Duration(budget)
+			return s.wait(ctx, s.last.Add(interval).Sub(s.now()))
+		}
+		if err := s.wait(ctx, reset.Sub(s.now())); err != nil {
+			return err
+		}
+		s.last = time.Time{}
+	}
+}
+
+// saveLocked saves the queue under the lock.
+func (s *Scheduler) saveLocked() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.save()
+}
+
+// save writes the queue to the state file, replacing it only once the new
+// state is completely written.
+func (s *Scheduler) save() error {
+	data, err := json.MarshalIndent(s.jobs, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error saving schedule: %w", err)
+	}
+	f, err := os.CreateTemp(filepath.Dir(s.path), ".schedule-*")
+	if err != nil {
+		return fmt.Errorf("error saving schedule: %w", err)
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return fmt.Errorf("error saving schedule: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("error saving schedule: %w", err)
+	}
+	if err := os.Rename(f.Name(), s.path); err != nil {
+		return fmt.Errorf("error saving schedule: %w", err)
+	}
+	return nil
+}
+
+// sleep waits for d or until the context is cancelled.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
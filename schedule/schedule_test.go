@@ -0,0 +1,212 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package schedule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/apidepot/digikey"
+	"github.com/apidepot/digikey/digikeytest"
+	"github.com/apidepot/digikey/internal/testhooks"
+)
+
+// fakeClock is a clock that advances only when waited on.
+type fakeClock struct {
+	now   time.Time
+	waits []time.Duration
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func (c *fakeClock) Wait(ctx context.Context, d time.Duration) error {
+	if d > 0 {
+		c.waits = append(c.waits, d)
+		c.now = c.now.Add(d)
+	}
+	return ctx.Err()
+}
+
+// newTestScheduler returns a scheduler of a fake server, whose client
+// tracks a daily quota of limit requests unless it is zero, and whose
+// clock starts at 22:00 UTC, two hours before the quota resets. Products
+// passed to the handler are appended to got.
+func newTestScheduler(t *testing.T, limit int, got *[]digikey.Product, opts ...Option) (*Scheduler, *digikeytest.Server, *fakeClock) {
+	t.Helper()
+	srv := digikeytest.NewServer("id", "secret")
+	t.Cleanup(srv.Close)
+	clock := &fakeClock{now: time.Date(2025, 6, 1, 22, 0, 0, 0, time.UTC)}
+	var clientOpts []digikey.ClientOption
+	if limit > 0 {
+		q := digikey.NewQuotaTracker(limit)
+		testhooks.SetQuotaClock(q, clock.Now)
+		clientOpts = append(clientOpts, digikey.WithQuotaTracker(q))
+	}
+	c, err := srv.NewClient("id", "secret", clientOpts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	handler := func(ctx context.Context, job Job, products []digikey.Product) error {
+		*got = append(*got, products...)
+		return nil
+	}
+	s, err := Open(c, filepath.Join(t.TempDir(), "schedule.json"), handler, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.now, s.wait = clock.Now, clock.Wait
+	return s, srv, clock
+}
+
+func TestPacing(t *testing.T) {
+	var got []digikey.Product
+	s, _, clock := newTestScheduler(t, 100, &got, WithQuotaShare(0.5))
+	ctx := context.Background()
+	if err := s.Add(ctx, DetailsJob("LM358DR"), DetailsJob("296-1395-1-ND"), DetailsJob("RC0603FR-0710KL")); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Run(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 3 || s.Pending() != 0 {
+		t.Errorf("Run() handled %d products with %d jobs pending, want 3 and none", len(got), s.Pending())
+	}
+
+	// The 49 requests left of the share of 50 are spread evenly over the
+	// two hours until the reset.
+	want := 2 * time.Hour / 49
+	if len(clock.waits) != 2 {
+		t.Fatalf("Run() waited %v, want two waits of %s", clock.waits, want)
+	}
+	for _, d := range clock.waits {
+		if d < want-time.Millisecond || d > want+time.Millisecond {
+			t.Errorf("Run() waited %v, want two waits of %s", clock.waits, want)
+			break
+		}
+	}
+}
+
+func TestPacingWaitsForReset(t *testing.T) {
+	var got []digikey.Product
+	s, _, clock := newTestScheduler(t, 10, &got, WithQuotaShare(0.2))
+	ctx := context.Background()
+
+	// Other requests use up the scheduler's share of 2.
+	for range 2 {
+		if _, err := s.client.Products.ProductDetails(ctx, "LM358DR"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := s.Add(ctx, DetailsJob("LM358DR")); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Run(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(clock.waits, []time.Duration{2 * time.Hour}) || len(got) != 1 {
+		t.Errorf("Run() waited %v and handled %d products, want to wait 2h for the reset and handle 1", clock.waits, len(got))
+	}
+	if used := s.client.QuotaTracker().Used(); used != 1 {
+		t.Errorf("quota used after the reset = %d, want 1", used)
+	}
+}
+
+func TestRetries(t *testing.T) {
+	var got []digikey.Product
+	s, srv, clock := newTestScheduler(t, 0, &got, WithMaxAttempts(3))
+	ctx := context.Background()
+	if err := s.Add(ctx, DetailsJob("LM358DR"), DetailsJob("NO-SUCH-PART")); err != nil {
+		t.Fatal(err)
+	}
+	srv.FailNext(http.StatusTooManyRequests, "slow down")
+	srv.FailNext(http.StatusInternalServerError, "oops")
+	srv.FailNext(http.StatusInternalServerError, "oops")
+	if err := s.Run(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// A throttled request waits and is not counted as an attempt; a
+	// request that keeps failing is retried until it succeeds or its
+	// attempts are used up; a permanent error fails the job at once.
+	if !slices.Equal(clock.waits, []time.Duration{digikey.DefaultRetryAfter}) {
+		t.Errorf("Run() waited %v, want %s after being throttled", clock.waits, digikey.DefaultRetryAfter)
+	}
+	jobs := s.Jobs()
+	if js := jobs[0]; js.Status != StatusDone || js.Attempts != 0 || js.Err != "" || len(got) != 1 {
+		t.Errorf("job after 2 failures: %+v, want done", js)
+	}
+	if js := jobs[1]; js.Status != StatusFailed || js.Attempts != 1 || js.Err == "" {
+		t.Errorf("job of an unknown part: %+v, want failed after 1 attempt", js)
+	}
+
+	if err := s.Add(ctx, DetailsJob("RC0603FR-0710KL")); err != nil {
+		t.Fatal(err)
+	}
+	for range 3 {
+		srv.FailNext(http.StatusServiceUnavailable, "down")
+	}
+	if err := s.Run(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if js := s.Jobs()[2]; js.Status != StatusFailed || js.Attempts != 3 {
+		t.Errorf("job failing 3 times: %+v, want failed after 3 attempts", js)
+	}
+}
+
+func TestResume(t *testing.T) {
+	var got []digikey.Product
+	s, srv, _ := newTestScheduler(t, 0, &got)
+	var products []digikey.Product
+	for i := range 5 {
+		products = append(products, digikey.Product{ManufacturerProductNumber: fmt.Sprintf("PART-%d", i+1)})
+	}
+	srv.SetProducts(products...)
+	ctx := context.Background()
+	if err := s.Add(ctx, SearchJob("parts", digikey.KeywordRequest{Keywords: "part", Limit: 2})); err != nil {
+		t.Fatal(err)
+	}
+
+	// The handler fails on the second page.
+	errStop := errors.New("stop")
+	handler := s.handler
+	s.handler = func(ctx context.Context, job Job, products []digikey.Product) error {
+		if len(got) > 0 {
+			return errStop
+		}
+		return handler(ctx, job, products)
+	}
+	if err := s.Run(ctx); !errors.Is(err, errStop) {
+		t.Fatalf("Run() = %v, want the handler's error", err)
+	}
+	if js := s.Jobs()[0]; js.Status != StatusPending || js.Offset != 2 || js.Total != 5 {
+		t.Fatalf("job after the first page: %+v, want pending at offset 2 of 5", js)
+	}
+
+	// A scheduler opened on the state file resumes at the failed page.
+	s, err := Open(s.client, s.path, handler)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Run(ctx); err != nil {
+		t.Fatal(err)
+	}
+	var mpns []string
+	for _, p := range got {
+		mpns = append(mpns, p.ManufacturerProductNumber)
+	}
+	if want := []string{"PART-1", "PART-2", "PART-3", "PART-4", "PART-5"}; !slices.Equal(mpns, want) {
+		t.Errorf("handled %v, want %v", mpns, want)
+	}
+	if js := s.Jobs()[0]; js.Status != StatusDone || js.Offset != 5 {
+		t.Errorf("job after resuming: %+v, want done at offset 5", js)
+	}
+}
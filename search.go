@@ -0,0 +1,315 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidSort is returned when a keyword search's sort options name a
+// field or direction DigiKey does not support.
+var ErrInvalidSort = errors.New("invalid sort options")
+
+// SortField is a field keyword search results can be sorted by.
+type SortField string
+
+// Sort fields.
+const (
+	SortByPrice                     SortField = "Price"
+	SortByQuantityAvailable         SortField = "QuantityAvailable"
+	SortByDigiKeyProductNumber      SortField = "DigiKeyProductNumber"
+	SortByManufacturerProductNumber SortField = "ManufacturerProductNumber"
+	SortByManufacturer              SortField = "Manufacturer"
+)
+
+// SortDirection is the direction of a sort.
+type SortDirection string
+
+// Sort directions.
+const (
+	Ascending  SortDirection = "Ascending"
+	Descending SortDirection = "Descending"
+)
+
+// Sort orders for keyword searches.
+var (
+	ByUnitPriceAsc              = SortOptions{Field: SortByPrice, SortOrder: Ascending}
+	ByUnitPriceDesc             = SortOptions{Field: SortByPrice, SortOrder: Descending}
+	ByQuantityAvailableDesc     = SortOptions{Field: SortByQuantityAvailable, SortOrder: Descending}
+	ByManufacturerAsc           = SortOptions{Field: SortByManufacturer, SortOrder: Ascending}
+	ByDigiKeyPartNumberAsc      = SortOptions{Field: SortByDigiKeyProductNumber, SortOrder: Ascending}
+	ByManufacturerPartNumberAsc = SortOptions{Field: SortByManufacturerProductNumber, SortOrder: Ascending}
+)
+
+// Valid reports whether the field is one DigiKey supports.
+func (f SortField) Valid() bool {
+	switch f {
+	case SortByPrice, SortByQuantityAvailable, SortByDigiKeyProductNumber, SortByManufacturerProductNumber, SortByManufacturer:
+		return true
+	}
+	return false
+}
+
+// Valid reports whether the direction is ascending or descending.
+func (d SortDirection) Valid() bool {
+	return d == Ascending || d == Descending
+}
+
+// Validate returns an error wrapping ErrInvalidSort if the field or
+// direction is unsupported, so that a typo fails before the request is
+// sent rather than being ignored by DigiKey.
+func (o SortOptions) Validate() error {
+	if !o.Field.Valid() {
+		return fmt.Errorf("%w: unknown field %q", ErrInvalidSort, o.Field)
+	}
+	if !o.SortOrder.Valid() {
+		return fmt.Errorf("%w: unknown direction %q", ErrInvalidSort, o.SortOrder)
+	}
+	return nil
+}
+
+// SearchRequest builds a KeywordRequest using a fluent interface, e.g.
+//
+//	req := NewSearchRequest("opamp").InStock().Manufacturer("Texas Instruments").Category(687).Sort(ByUnitPriceAsc).Limit(50)
+//	resp, err := c.Products.KeywordSearch(ctx, req.Build())
+type SearchRequest struct {
+	req KeywordRequest
+}
+
+// NewSearchRequest starts a search request for the given keywords.
+func NewSearchRequest(keywords string) *SearchRequest {
+	return &SearchRequest{req: KeywordRequest{Keywords: keywords}}
+}
+
+// InStockOnly limits the results to products that are in stock, using the
+// InStock search option of the V4 API.
+func (r *SearchRequest) InStockOnly() *SearchRequest {
+	return r.searchOption("InStock")
+}
+
+// InStock is equivalent to InStockOnly.
+func (r *SearchRequest) InStock() *SearchRequest {
+	return r.InStockOnly()
+}
+
+// MinimumQuantityAvailable limits the results to products with at least n
+// units in stock, e.g. the quantity a build needs. A non-positive n removes
+// the limit.
+func (r *SearchRequest) MinimumQuantityAvailable(n int) *SearchRequest {
+	r.filters().MinimumQuantityAvailable = max(n, 0)
+	return r
+}
+
+// NormallyStocking limits the results to products DigiKey normally stocks,
+// rather than orders in on demand.
+func (r *SearchRequest) NormallyStocking() *SearchRequest {
+	return r.searchOption("NormallyStocking")
+}
+
+// Manufacturer limits the results to the manufacturers with the given
+// names, e.g. "Texas Instruments", matched case-insensitively. A name that
+// is a number is taken as a manufacturer ID. Names are resolved to IDs when
+// the search is run, from a manufacturer list the client requests once a
+// day, and a name matching no manufacturer fails the search with
+// ErrUnknownManufacturer.
+func (r *SearchRequest) Manufacturer(names ...string) *SearchRequest {
+	for _, name := range names {
+		if id, err := strconv.Atoi(strings.TrimSpace(name)); err == nil {
+			r.ManufacturerID(id)
+			continue
+		}
+		if !slices.Contains(r.req.manufacturerNames, name) {
+			r.req.manufacturerNames = append(r.req.manufacturerNames, name)
+		}
+	}
+	return r
+}
+
+// ManufacturerID limits the results to the given manufacturer IDs, such as
+// those of the manufacturer filter options of a previous search.
+func (r *SearchRequest) ManufacturerID(ids ...int) *SearchRequest {
+	f := r.filters()
+	f.ManufacturerFilter = appendIDs(f.ManufacturerFilter, ids)
+	return r
+}
+
+// Category limits the results to the given category IDs.
+func (r *SearchRequest) Category(ids ...int) *SearchRequest {
+	f := r.filters()
+	f.CategoryFilter = appendIDs(f.CategoryFilter, ids)
+	return r
+}
+
+// ByManufacturer is equivalent to Manufacturer.
+func (r *SearchRequest) ByManufacturer(names ...string) *SearchRequest {
+	return r.Manufacturer(names...)
+}
+
+// WithinCategory limits the results to the category and, if
+// includeChildren is set, to all of its descendants. The descendants are
+// looked up with CategoryByID when the search is run, so they are current.
+func (r *SearchRequest) WithinCategory(categoryID int, includeChildren bool) *SearchRequest {
+	r.Category(categoryID)
+	if includeChildren && !slices.Contains(r.req.subtrees, categoryID) {
+		r.req.subtrees = append(r.req.subtrees, categoryID)
+	}
+	return r
+}
+
+// Status limits the results to the given product status IDs.
+func (r *SearchRequest) Status(ids ...int) *SearchRequest {
+	f := r.filters()
+	f.StatusFilter = appendIDs(f.StatusFilter, ids)
+	return r
+}
+
+// Packaging limits the results to the given packaging IDs.
+func (r *SearchRequest) Packaging(ids ...int) *SearchRequest {
+	f := r.filters()
+	f.PackagingFilter = appendIDs(f.PackagingFilter, ids)
+	return r
+}
+
+// Series limits the results to the given series IDs.
+func (r *SearchRequest) Series(ids ...int) *SearchRequest {
+	f := r.filters()
+	f.SeriesFilter = appendIDs(f.SeriesFilter, ids)
+	return r
+}
+
+// ExcludeMarketPlace removes marketplace products from the results.
+func (r *SearchRequest) ExcludeMarketPlace() *SearchRequest {
+	r.filters().MarketPlaceFilter = "ExcludeMarketPlace"
+	return r
+}
+
+// Parameter limits the results to products in the category whose parameter
+// has one of the given value IDs. Parametric filters apply to a single
+// category, so the category of the last call wins.
+func (r *SearchRequest) Parameter(categoryID, parameterID int, valueIDs ...string) *SearchRequest {
+	f := r.filters()
+	if f.ParameterFilterRequest == nil {
+		f.ParameterFilterRequest = &ParameterFilterRequest{}
+	}
+	pf := f.ParameterFilterRequest
+	pf.CategoryFilter = FilterID{ID: strconv.Itoa(categoryID)}
+	values := make([]FilterID, len(valueIDs))
+	for i, id := range valueIDs {
+		values[i] = FilterID{ID: id}
+	}
+	pf.ParameterFilters = append(pf.ParameterFilters, ParameterFilter{
+		ParameterID:  parameterID,
+		FilterValues: values,
+	})
+	return r
+}
+
+// Sort sets the order of the results.
+func (r *SearchRequest) Sort(s SortOptions) *SearchRequest {
+	r.req.SortOptions = &s
+	return r
+}
+
+// SortBy sets the order of the results by field and direction.
+func (r *SearchRequest) SortBy(field SortField, dir SortDirection) *SearchRequest {
+	return r.Sort(SortOptions{Field: field, SortOrder: dir})
+}
+
+// Limit sets the maximum number of products to return.
+func (r *SearchRequest) Limit(n int) *SearchRequest {
+	r.req.Limit = n
+	return r
+}
+
+// Offset sets the number of products to skip.
+func (r *SearchRequest) Offset(n int) *SearchRequest {
+	r.req.Offset = n
+	return r
+}
+
+// Build returns the keyword search request. The builder may continue to be
+// used afterwards without affecting the returned request.
+func (r *SearchRequest) Build() KeywordRequest {
+	req := r.req
+	req.subtrees = slices.Clone(r.req.subtrees)
+	req.manufacturerNames = slices.Clone(r.req.manufacturerNames)
+	if r.req.FilterOptionsRequest != nil {
+		f := *r.req.FilterOptionsRequest
+		f.ManufacturerFilter = cloneIDs(f.ManufacturerFilter)
+		f.CategoryFilter = cloneIDs(f.CategoryFilter)
+		f.StatusFilter = cloneIDs(f.StatusFilter)
+		f.PackagingFilter = cloneIDs(f.PackagingFilter)
+		f.SeriesFilter = cloneIDs(f.SeriesFilter)
+		f.SearchOptions = append([]string(nil), f.SearchOptions...)
+		if f.ParameterFilterRequest != nil {
+			pf := *f.ParameterFilterRequest
+			pf.ParameterFilters = append([]ParameterFilter(nil), pf.ParameterFilters...)
+			f.ParameterFilterRequest = &pf
+		}
+		req.FilterOptionsRequest = &f
+	}
+	if r.req.SortOptions != nil {
+		s := *r.req.SortOptions
+		req.SortOptions = &s
+	}
+	return req
+}
+
+// Resolve returns the request with the category subtrees and manufacturer
+// names set by WithinCategory and Manufacturer looked up and replaced by
+// category and manufacturer IDs in its filters, so the request can be saved,
+// e.g. as JSON, or sent repeatedly without looking them up again. Searches
+// resolve requests themselves, so calling Resolve is never required.
+func (s *ProductsService) Resolve(ctx context.Context, req KeywordRequest, opts ...RequestOption) (KeywordRequest, error) {
+	return s.resolve(ctx, req, opts)
+}
+
+// resolve returns the request with the category subtrees and manufacturer
+// names of the builder looked up and added to its filters.
+func (s *ProductsService) resolve(ctx context.Context, req KeywordRequest, opts []RequestOption) (KeywordRequest, error) {
+	req, err := s.expandSubtrees(ctx, req, opts)
+	if err != nil {
+		return req, err
+	}
+	return s.resolveManufacturers(ctx, req, opts)
+}
+
+func (r *SearchRequest) filters() *FilterOptionsRequest {
+	if r.req.FilterOptionsRequest == nil {
+		r.req.FilterOptionsRequest = &FilterOptionsRequest{}
+	}
+	return r.req.FilterOptionsRequest
+}
+
+func (r *SearchRequest) searchOption(option string) *SearchRequest {
+	f := r.filters()
+	for _, o := range f.SearchOptions {
+		if o == option {
+			return r
+		}
+	}
+	f.SearchOptions = append(f.SearchOptions, option)
+	return r
+}
+
+func appendIDs(filter []FilterID, ids []int) []FilterID {
+	for _, id := range ids {
+		filter = append(filter, FilterID{ID: strconv.Itoa(id)})
+	}
+	return filter
+}
+
+func cloneIDs(ids []FilterID) []FilterID {
+	if ids == nil {
+		return nil
+	}
+	return append([]FilterID(nil), ids...)
+}
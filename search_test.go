@@ -0,0 +1,51 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/apidepot/digikey"
+	"github.com/apidepot/digikey/digikeytest"
+)
+
+func TestSearchRequestManufacturer(t *testing.T) {
+	srv := digikeytest.NewServer("id", "secret")
+	defer srv.Close()
+	c, err := srv.NewClient("id", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	req := digikey.NewSearchRequest("opamp").InStock().Manufacturer("texas instruments", "311").
+		Category(687).Sort(digikey.ByUnitPriceAsc).Limit(50).Build()
+	resolved, err := c.Products.Resolve(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := resolved.FilterOptionsRequest.ManufacturerFilter
+	want := []digikey.FilterID{{ID: "311"}, {ID: "296"}}
+	if !slices.Equal(got, want) {
+		t.Errorf("manufacturer filter = %v, want %v", got, want)
+	}
+
+	resp, err := c.Products.KeywordSearch(ctx, digikey.NewSearchRequest("lm358").Manufacturer("Texas Instruments").Build())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Products) != 1 || resp.Products[0].Manufacturer.ID != 296 {
+		t.Errorf("search by manufacturer name = %+v", resp.Products)
+	}
+
+	_, err = c.Products.KeywordSearch(ctx, digikey.NewSearchRequest("lm358").Manufacturer("No Such Semi").Build())
+	if !errors.Is(err, digikey.ErrUnknownManufacturer) {
+		t.Errorf("search by unknown manufacturer: %v, want ErrUnknownManufacturer", err)
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import (
+	"context"
+	"sync"
+)
+
+// DefaultConcurrency is the number of requests bulk operations have in
+// flight at once, unless changed with WithConcurrency.
+const DefaultConcurrency = 4
+
+// SearchManyLimit is the number of products SearchMany requests for each
+// keyword.
+const SearchManyLimit = 10
+
+// SearchResult is the result of one keyword search of SearchMany.
+type SearchResult struct {
+	Response *KeywordResponse
+	Err      error
+}
+
+// WithConcurrency sets the number of requests bulk operations, such as
+// SearchMany, LifecycleAudit, and BOM enrichment, have in flight at once.
+// Requests are still subject to the rate limiter.
+func WithConcurrency(n int) ClientOption {
+	return func(client *Client) {
+		client.concurrency = max(n, 1)
+	}
+}
+
+// Concurrency returns the number of requests bulk operations have in flight
+// at once, as set by WithConcurrency, so that those built on the client
+// outside this package can honor it.
+func (c *Client) Concurrency() int {
+	return c.concurrency
+}
+
+// SearchMany runs a keyword search for each of the keywords, with bounded
+// concurrency, and returns the results keyed by keyword. Each search
+// requests the top SearchManyLimit products. A failed search is reported in
+// its result and does not stop the others.
+func (s *ProductsService) SearchMany(ctx context.Context, keywords []string, opts ...RequestOption) map[string]SearchResult {
+	results := make(map[string]SearchResult, len(keywords))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, s.client.concurrency)
+	seen := make(map[string]bool, len(keywords))
+	for _, k := range keywords {
+		if seen[k] {
+			continue
+		}
+		seen[k] = true
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var r SearchResult
+			select {
+			case sem <- struct{}{}:
+				r.Response, r.Err = s.KeywordSearch(ctx, KeywordRequest{Keywords: k, Limit: SearchManyLimit}, opts...)
+				<-sem
+			case <-ctx.Done():
+				r.Err = ctx.Err()
+			}
+			mu.Lock()
+			results[k] = r
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	return results
+}
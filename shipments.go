@@ -0,0 +1,107 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import (
+	"net/url"
+	"strings"
+	"unicode"
+)
+
+// Shipment is a shipment of some or all of a sales order, which may be
+// packed in several boxes.
+type Shipment struct {
+	Carrier        string `json:"Carrier"`
+	ShipMethod     string `json:"ShipMethod"`
+	TrackingNumber string `json:"TrackingNumber"`
+	TrackingURL    string `json:"TrackingUrl"`
+	InvoiceID      int    `json:"InvoiceId"`
+	ShippedDate    Time   `json:"ShippedDate"`
+	Boxes          []Box  `json:"Boxes"`
+}
+
+// Box is a single package of a shipment.
+type Box struct {
+	BoxID          string       `json:"BoxId"`
+	TrackingNumber string       `json:"TrackingNumber"`
+	Contents       []BoxContent `json:"Contents"`
+}
+
+// BoxContent is the quantity of a product packed in a box.
+type BoxContent struct {
+	DigiKeyProductNumber      string `json:"DigiKeyProductNumber"`
+	ManufacturerProductNumber string `json:"ManufacturerProductNumber"`
+	Quantity                  int    `json:"Quantity"`
+}
+
+// TrackingNumbers returns the distinct tracking numbers of the order's
+// shipments, boxes, and line item shipments.
+func (o *SalesOrder) TrackingNumbers() []string {
+	var numbers []string
+	seen := make(map[string]bool)
+	add := func(n string) {
+		n = strings.TrimSpace(n)
+		if n != "" && !seen[n] {
+			seen[n] = true
+			numbers = append(numbers, n)
+		}
+	}
+	for _, s := range o.Shipments {
+		add(s.TrackingNumber)
+		for _, b := range s.Boxes {
+			add(b.TrackingNumber)
+		}
+	}
+	for _, l := range o.LineItems {
+		for _, s := range l.ItemShipments {
+			add(s.TrackingNumber)
+		}
+	}
+	return numbers
+}
+
+// TrackingURL returns the carrier's tracking page for the tracking number.
+// If carrier is empty or unrecognized, the carrier is guessed from the
+// format of the tracking number. It returns an empty string if the carrier
+// cannot be determined.
+func TrackingURL(carrier, trackingNumber string) string {
+	n := strings.ToUpper(strings.Join(strings.Fields(trackingNumber), ""))
+	if n == "" {
+		return ""
+	}
+	c := strings.ToLower(carrier)
+	switch {
+	case strings.Contains(c, "ups"):
+		return "https://www.ups.com/track?tracknum=" + url.QueryEscape(n)
+	case strings.Contains(c, "fedex"):
+		return "https://www.fedex.com/fedextrack/?trknbr=" + url.QueryEscape(n)
+	case strings.Contains(c, "usps") || strings.Contains(c, "postal"):
+		return "https://tools.usps.com/go/TrackConfirmAction?tLabels=" + url.QueryEscape(n)
+	case strings.Contains(c, "dhl"):
+		return "https://www.dhl.com/en/express/tracking.html?AWB=" + url.QueryEscape(n)
+	}
+
+	switch digits := isDigits(n); {
+	case strings.HasPrefix(n, "1Z") && len(n) == 18:
+		return TrackingURL("UPS", n)
+	case digits && (len(n) == 12 || len(n) == 15):
+		return TrackingURL("FedEx", n)
+	case digits && len(n) >= 20 && len(n) <= 22 && n[0] == '9':
+		return TrackingURL("USPS", n)
+	case digits && len(n) == 10:
+		return TrackingURL("DHL", n)
+	}
+	return ""
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return s != ""
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// snapshotMagic starts the header line of a snapshot, which is followed by
+// the name of the snapshot's codec.
+const snapshotMagic = "digikey-snapshot/1 "
+
+// ErrUnknownCodec is returned when reading a snapshot written with a codec
+// that was not passed to ReadSnapshot.
+var ErrUnknownCodec = errors.New("unknown codec")
+
+// Snapshot is a set of products captured at a point in time, such as a
+// crawled category or the parts of a BOM, kept to be compared or loaded
+// again later without requests.
+type Snapshot struct {
+	TakenAt  time.Time
+	Products []Product
+}
+
+// WriteSnapshot writes the snapshot serialized with the codec, preceded by
+// a header recording the codec's name. Binary codecs, such as GobCodec or
+// the protobuf codec of package digikeypb, write snapshots of many products
+// considerably smaller and faster to load than JSONCodec.
+func WriteSnapshot(w io.Writer, codec Codec, s *Snapshot) error {
+	data, err := codec.Marshal(s)
+	if err != nil {
+		return fmt.Errorf("error encoding snapshot: %w", err)
+	}
+	if _, err := io.WriteString(w, snapshotMagic+codec.Name()+"\n"); err != nil {
+		return fmt.Errorf("error writing snapshot: %w", err)
+	}
+	if _, err := w.Write(data); err != nil {
+		return fmt.Errorf("error writing snapshot: %w", err)
+	}
+	return nil
+}
+
+// ReadSnapshot reads a snapshot written by WriteSnapshot with JSONCodec,
+// GobCodec, or one of the given codecs, chosen by the name in its header.
+func ReadSnapshot(r io.Reader, codecs ...Codec) (*Snapshot, error) {
+	br := bufio.NewReader(r)
+	header, err := br.ReadString('\n')
+	if err != nil || !strings.HasPrefix(header, snapshotMagic) {
+		return nil, errors.New("error reading snapshot: missing header")
+	}
+	name := strings.TrimSpace(strings.TrimPrefix(header, snapshotMagic))
+	var codec Codec
+	for _, c := range append([]Codec{JSONCodec, GobCodec}, codecs...) {
+		if c.Name() == name {
+			codec = c
+		}
+	}
+	if codec == nil {
+		return nil, fmt.Errorf("error reading snapshot: %w %q", ErrUnknownCodec, name)
+	}
+	data, err := io.ReadAll(br)
+	if err != nil {
+		return nil, fmt.Errorf("error reading snapshot: %w", err)
+	}
+	s := &Snapshot{}
+	if err := codec.Unmarshal(data, s); err != nil {
+		return nil, fmt.Errorf("error decoding snapshot: %w", err)
+	}
+	return s, nil
+}
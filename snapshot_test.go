@@ -0,0 +1,49 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey_test
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/apidepot/digikey"
+	"github.com/apidepot/digikey/digikeytest"
+)
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	want := &digikey.Snapshot{
+		TakenAt:  time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC),
+		Products: digikeytest.DefaultCatalog(),
+	}
+	for _, codec := range []digikey.Codec{digikey.JSONCodec, digikey.GobCodec} {
+		t.Run(codec.Name(), func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := digikey.WriteSnapshot(&buf, codec, want); err != nil {
+				t.Fatal(err)
+			}
+			got, err := digikey.ReadSnapshot(&buf)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("ReadSnapshot() = %+v, want %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestReadSnapshotUnknownCodec(t *testing.T) {
+	_, err := digikey.ReadSnapshot(bytes.NewReader([]byte("digikey-snapshot/1 zstd\n")))
+	if !errors.Is(err, digikey.ErrUnknownCodec) {
+		t.Errorf("ReadSnapshot() error = %v, want %v", err, digikey.ErrUnknownCodec)
+	}
+	if _, err := digikey.ReadSnapshot(bytes.NewReader([]byte(`{"Products":[]}`))); err == nil {
+		t.Error("ReadSnapshot() of a file without header succeeded")
+	}
+}
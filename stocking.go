@@ -0,0 +1,31 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import "slices"
+
+// IsNormallyStocking reports whether DigiKey keeps the product in stock
+// continuously: it is flagged as normally stocking and is neither
+// discontinued nor at the end of its life, which ends restocking.
+func (p Product) IsNormallyStocking() bool {
+	return p.NormallyStocking && !p.Discontinued && !p.EndOfLife
+}
+
+// PreferNormallyStocking sorts the products in place so those DigiKey
+// normally stocks come first, keeping the order of the products otherwise,
+// e.g. to rank search results for a design that must be rebuilt later.
+func PreferNormallyStocking(products []Product) {
+	slices.SortStableFunc(products, func(a, b Product) int {
+		switch {
+		case a.IsNormallyStocking() == b.IsNormallyStocking():
+			return 0
+		case a.IsNormallyStocking():
+			return -1
+		default:
+			return 1
+		}
+	})
+}
@@ -0,0 +1,169 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// DecodeStream decodes the JSON object read from r one element at a time
+// from the array in its top-level field, calling fn with each element in
+// turn, so that a large response is never held in memory whole. The other
+// top-level fields are decoded into rest, unless it is nil, once the object
+// has been read. An error from fn stops decoding and is returned.
+func DecodeStream[T any](r io.Reader, field string, rest any, fn func(T) error) error {
+	dec := json.NewDecoder(r)
+	if err := expectDelim(dec, '{'); err != nil {
+		return err
+	}
+	others := map[string]json.RawMessage{}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return fmt.Errorf("error decoding stream: %w", err)
+		}
+		key, _ := tok.(string)
+		if key != field {
+			var raw json.RawMessage
+			if err := dec.Decode(&raw); err != nil {
+				return fmt.Errorf("error decoding stream field %s: %w", key, err)
+			}
+			others[key] = raw
+			continue
+		}
+		if err := decodeArray(dec, field, fn); err != nil {
+			return err
+		}
+	}
+	if err := expectDelim(dec, '}'); err != nil {
+		return err
+	}
+	if rest == nil || len(others) == 0 {
+		return nil
+	}
+	data, err := json.Marshal(others)
+	if err != nil {
+		return fmt.Errorf("error decoding stream: %w", err)
+	}
+	if err := json.Unmarshal(data, rest); err != nil {
+		return fmt.Errorf("error decoding stream: %w", err)
+	}
+	return nil
+}
+
+// decodeArray decodes the elements of the array at the decoder's position,
+// calling fn with each. A null array has no elements.
+func decodeArray[T any](dec *json.Decoder, field string, fn func(T) error) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return fmt.Errorf("error decoding stream field %s: %w", field, err)
+	}
+	if tok == nil {
+		return nil
+	}
+	if d, ok := tok.(json.Delim); !ok || d != '[' {
+		return fmt.Errorf("error decoding stream field %s: not an array", field)
+	}
+	for dec.More() {
+		var v T
+		if err := dec.Decode(&v); err != nil {
+			return fmt.Errorf("error decoding stream field %s: %w", field, err)
+		}
+		if err := fn(v); err != nil {
+			return err
+		}
+	}
+	return expectDelim(dec, ']')
+}
+
+// expectDelim reads the next token and checks that it is the delimiter.
+func expectDelim(dec *json.Decoder, want json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return fmt.Errorf("error decoding stream: %w", err)
+	}
+	if d, ok := tok.(json.Delim); !ok || d != want {
+		return fmt.Errorf("error decoding stream: expected %v, got %v", want, tok)
+	}
+	return nil
+}
+
+// KeywordSearchStream runs a keyword search like KeywordSearch but calls fn
+// with each product as it is decoded from the response, rather than
+// buffering the response, to bound memory in bulk crawls of large pages. It
+// returns the rest of the response, without its products. Streamed searches
+// use the v4 API and bypass the cache.
+//
+// Since the response states its locale only after the products, their
+// prices are in the currency requested with WithRequestLocale, or else in
+// DefaultCurrency.
+func (s *ProductsService) KeywordSearchStream(ctx context.Context, req KeywordRequest, fn func(Product) error, opts ...RequestOption) (*KeywordResponse, error) {
+	if err := req.validate(); err != nil {
+		return nil, err
+	}
+	req, err := s.resolve(ctx, req, opts)
+	if err != nil {
+		return nil, err
+	}
+	resp := &KeywordResponse{}
+	body, err := s.client.streamJSON(ctx, "POST", productsPath+"keyword", req, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	currency := requestCurrency(opts)
+	err = DecodeStream(body, "Products", resp, func(p Product) error {
+		fillCurrency(&p, currency)
+		return fn(p)
+	})
+	if err != nil {
+		return nil, err
+	}
+	fillCurrency(resp, currency)
+	return resp, nil
+}
+
+// CategoriesStream calls fn with each top-level product category, and its
+// child categories, as it is decoded from the response rather than
+// buffering the response. Streamed requests bypass the cache.
+func (s *ProductsService) CategoriesStream(ctx context.Context, fn func(Category) error, opts ...RequestOption) error {
+	body, err := s.client.streamJSON(ctx, "GET", productsPath+"categories", nil, opts)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+	return DecodeStream(body, "Categories", nil, fn)
+}
+
+// streamJSON sends a request to the endpoint, with body marshaled to JSON
+// unless it is nil, and returns the response body to be streamed.
+func (c *Client) streamJSON(ctx context.Context, method, endpoint string, body any, opts []RequestOption) (io.ReadCloser, error) {
+	u, err := c.url(endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	var r io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling request body: %w", err)
+		}
+		r = bytes.NewReader(data)
+	}
+	req, err := http.NewRequest(method, u.String(), r)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	return c.stream(ctx, req, opts...)
+}
@@ -0,0 +1,39 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import "context"
+
+// ProductSubstitute is a product DigiKey suggests in place of another.
+// SubstituteType describes how closely it matches, e.g. "Direct",
+// "Parametric Equivalent", or "Similar".
+type ProductSubstitute struct {
+	SubstituteType            string       `json:"SubstituteType"`
+	ProductURL                string       `json:"ProductUrl"`
+	Description               string       `json:"Description"`
+	Manufacturer              Manufacturer `json:"Manufacturer"`
+	ManufacturerProductNumber string       `json:"ManufacturerProductNumber"`
+	DigiKeyProductNumber      string       `json:"DigiKeyProductNumber"`
+	UnitPrice                 string       `json:"UnitPrice"`
+	QuantityAvailable         int          `json:"QuantityAvailable"`
+}
+
+// substitutionsResponse is the response to a product substitutions request.
+type substitutionsResponse struct {
+	ProductSubstitutesCount int                 `json:"ProductSubstitutesCount"`
+	ProductSubstitutes      []ProductSubstitute `json:"ProductSubstitutes"`
+	SearchLocaleUsed        Locale              `json:"SearchLocaleUsed"`
+}
+
+// Substitutions returns the substitute products DigiKey suggests for the
+// product with the given DigiKey or manufacturer product number.
+func (s *ProductsService) Substitutions(ctx context.Context, partNumber string, opts ...RequestOption) ([]ProductSubstitute, error) {
+	resp := substitutionsResponse{}
+	if err := s.client.getJSON(ctx, productPath(partNumber, "substitutions"), nil, &resp, opts...); err != nil {
+		return nil, err
+	}
+	return resp.ProductSubstitutes, nil
+}
@@ -6,14 +6,77 @@
 package digikey
 
 import (
-	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"math/rand/v2"
 	"net/http"
+	"net/url"
+	"strings"
 	"time"
+
+	"github.com/apidepot/digikey/internal/testhooks"
+)
+
+// DefaultTokenRefreshMargin is how long before it expires an access token
+// is renewed, unless changed with WithTokenRefreshMargin.
+const DefaultTokenRefreshMargin = 30 * time.Second
+
+// Default bounds of the backoff between failed access token requests,
+// unless changed with WithTokenBackoff.
+const (
+	DefaultTokenBackoff    = time.Second
+	DefaultTokenBackoffMax = time.Minute
 )
 
+// TokenError is returned when an access token cannot be obtained. After a
+// failure, requests fail fast with the same error until RetryAt, rather
+// than each calling the token endpoint again.
+type TokenError struct {
+	Err error
+	// Failures is the number of consecutive failed token requests.
+	Failures int
+	// RetryAt is the time before which no new token request is made.
+	RetryAt time.Time
+}
+
+// Error implements the error interface.
+func (e *TokenError) Error() string {
+	return fmt.Sprintf("error getting access token (%d consecutive failures, retrying after %s): %v",
+		e.Failures, e.RetryAt.Format(time.RFC3339), e.Err)
+}
+
+// Unwrap returns the error of the last token request.
+func (e *TokenError) Unwrap() error {
+	return e.Err
+}
+
+// WithTokenBackoff sets the backoff after a failed access token request,
+// which doubles with each consecutive failure from base up to max, with
+// jitter so that many clients don't retry in lockstep.
+func WithTokenBackoff(base, max time.Duration) ClientOption {
+	return func(client *Client) {
+		client.tokenBackoff = base
+		client.tokenBackoffMax = max
+	}
+}
+
+// tokenBackoffDelay returns the delay before the next token request after
+// the given number of consecutive failures, drawn uniformly from the upper
+// half of the exponential delay.
+func (c *Client) tokenBackoffDelay(failures int) time.Duration {
+	d := c.tokenBackoff
+	for i := 1; i < failures && d < c.tokenBackoffMax; i++ {
+		d *= 2
+	}
+	d = min(d, c.tokenBackoffMax)
+	if d <= 0 {
+		return 0
+	}
+	return d/2 + rand.N(d/2+1)
+}
+
 // accessToken provides the response for a successful access token request.
 // ExpiresIn is in seconds.
 type accessToken struct {
@@ -24,42 +87,101 @@ type accessToken struct {
 
 // getAccessToken returns the current access token or refreshes the access
 // token using the client ID and client secret.
-func (c *Client) getAccessToken() (string, error) {
+func (c *Client) getAccessToken(ctx context.Context) (string, error) {
+	if c.offline {
+		return "", ErrOffline
+	}
 	c.mu.RLock()
-	if time.Now().Before(c.tokenExpiresAt) {
+	margin := c.refreshMargin()
+	if c.staticToken || time.Now().Add(margin).Before(c.tokenExpiresAt) {
 		token := c.accessToken
 		c.mu.RUnlock()
 		return token, nil
 	}
 	c.mu.RUnlock()
 
-	// Token is expred, so refresh.
-	return c.refreshToken()
+	// Token is expired or about to, so refresh.
+	return c.refreshToken(ctx, margin)
+}
+
+// refreshMargin returns how long before expiry the token is renewed, which
+// is at most half its lifetime so a short-lived token is not renewed on
+// every request. The caller must hold the lock.
+func (c *Client) refreshMargin() time.Duration {
+	return min(c.tokenRefreshMargin, c.tokenLifetime/2)
+}
+
+// WithTokenRefreshMargin sets how long before it expires an access token is
+// renewed, so that a request sent just before expiry does not reach the API
+// with an expired token. Renewal is never earlier than halfway through the
+// token's lifetime.
+func WithTokenRefreshMargin(d time.Duration) ClientOption {
+	return func(client *Client) {
+		client.tokenRefreshMargin = max(d, 0)
+	}
+}
+
+// TokenExpiresAt returns the time the current access token expires, which
+// is the zero time for a token set with WithAccessToken.
+func (c *Client) TokenExpiresAt() time.Time {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.staticToken {
+		return time.Time{}
+	}
+	return c.tokenExpiresAt
 }
 
-func (c *Client) refreshToken() (string, error) {
+// refreshToken requests a new access token unless the current one is valid
+// for longer than margin, e.g. because another caller refreshed it while
+// this one waited for the lock. If the last request failed too recently,
+// its error is returned again. A request abandoned because the context is
+// done does not count as a failure.
+func (c *Client) refreshToken(ctx context.Context, margin time.Duration) (string, error) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	requestBody := struct {
-		ID     string `json:"client_id"`
-		Secret string `json:"client_secret"`
-		Type   string `json:"grant_type"`
-	}{
-		ID:     c.id,
-		Secret: c.secret,
-		Type:   grantType,
+	if time.Now().Add(margin).Before(c.tokenExpiresAt) {
+		return c.accessToken, nil
 	}
-	data, err := json.Marshal(requestBody)
+	if c.tokenErr != nil && time.Now().Before(c.tokenErr.RetryAt) {
+		return "", c.tokenErr
+	}
+	token, err := c.requestToken(ctx)
 	if err != nil {
-		return "", fmt.Errorf("error marshaling access token body: %w", err)
+		if ctx.Err() != nil {
+			return "", fmt.Errorf("error getting access token: %w", err)
+		}
+		failures := 1
+		if c.tokenErr != nil {
+			failures = c.tokenErr.Failures + 1
+		}
+		c.tokenErr = &TokenError{
+			Err:      err,
+			Failures: failures,
+			RetryAt:  time.Now().Add(c.tokenBackoffDelay(failures)),
+		}
+		return "", c.tokenErr
 	}
+	c.tokenErr = nil
+	return token, nil
+}
 
-	resp, err := c.httpClient.Post(
-		c.accessTokenURL,
-		"application/x-www-form-urlencoded",
-		bytes.NewReader(data),
-	)
+// requestToken requests a new access token with the client credentials
+// flow. The caller must hold the write lock.
+func (c *Client) requestToken(ctx context.Context) (string, error) {
+	form := url.Values{}
+	form.Set("client_id", c.id)
+	form.Set("client_secret", c.secret)
+	form.Set("grant_type", grantType)
+
+	req, err := http.NewRequestWithContext(ctx, "POST", c.accessTokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("error creating request for new access token: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("User-Agent", c.userAgent)
+	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		return "", fmt.Errorf("error in post request for new access token: %w", err)
 	}
@@ -84,11 +206,93 @@ func (c *Client) refreshToken() (string, error) {
 		return "", fmt.Errorf("error unmarshaling response body: %w", err)
 	}
 
-	// Remove one second from the time to expriration to be safe.
 	c.accessToken = accessToken.Token
 	c.tokenType = accessToken.Type
-	c.tokenExpiresAt = time.Now().Add(time.Duration(accessToken.ExpiresIn - 1))
+	c.tokenLifetime = time.Duration(accessToken.ExpiresIn) * time.Second
+	c.tokenExpiresAt = time.Now().Add(c.tokenLifetime)
 
 	return c.accessToken, nil
+}
+
+// WithAccessToken uses the given access token for every request instead of
+// requesting one with the client credentials flow. Customer-specific APIs,
+// such as MyLists and Order Status, require a token obtained through the
+// three-legged OAuth2 flow. The caller is responsible for replacing the
+// client before the token expires.
+func WithAccessToken(token string) ClientOption {
+	return func(client *Client) {
+		client.accessToken = token
+		client.tokenType = "Bearer"
+		client.staticToken = true
+	}
+}
+
+func init() {
+	testhooks.ExpireToken = func(c any) { c.(*Client).expireToken() }
+}
 
+// expireToken marks the current access token as expired, so the next
+// request fetches a new one. Tests reach it through digikeytest.ExpireToken.
+func (c *Client) expireToken() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.tokenExpiresAt = time.Now()
+}
+
+// minTokenRefreshInterval is the least time the background refresher waits
+// between token requests, so a token that expires within the refresh window
+// does not make it spin.
+const minTokenRefreshInterval = time.Second
+
+// WithTokenRefresher refreshes the access token in a background goroutine
+// the window before it expires, so that requests never wait for a token
+// request. Stop the goroutine with Close. It has no effect with
+// WithAccessToken.
+func WithTokenRefresher(window time.Duration) ClientOption {
+	return func(client *Client) {
+		client.refreshWindow = window
+	}
+}
+
+// startTokenRefresher starts the background token refresher.
+func (c *Client) startTokenRefresher() {
+	ctx, cancel := context.WithCancel(context.Background())
+	c.refresherCancel = cancel
+	c.refresherStopped = make(chan struct{})
+	go c.runTokenRefresher(ctx)
+}
+
+func (c *Client) runTokenRefresher(ctx context.Context) {
+	defer close(c.refresherStopped)
+	for {
+		c.mu.RLock()
+		next := c.tokenExpiresAt.Add(-c.refreshWindow)
+		if c.tokenErr != nil {
+			next = c.tokenErr.RetryAt
+		}
+		c.mu.RUnlock()
+
+		timer := time.NewTimer(max(time.Until(next), minTokenRefreshInterval))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		// Failures are recorded for the next request to report and delay
+		// the next attempt.
+		_, _ = c.refreshToken(ctx, c.refreshWindow)
+	}
+}
+
+// Close stops the background token refresher, if any, cancelling a token
+// request in flight, and waits for it to exit. It is safe to call more than
+// once. The client remains usable, fetching tokens as requests need them.
+func (c *Client) Close() error {
+	if c.refresherCancel == nil {
+		return nil
+	}
+	c.closeOnce.Do(c.refresherCancel)
+	<-c.refresherStopped
+	return nil
 }
@@ -0,0 +1,173 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/apidepot/digikey"
+	"github.com/apidepot/digikey/digikeytest"
+)
+
+// newTokenTestClient returns a client of a new fake server issuing tokens
+// valid for expiresIn seconds.
+func newTokenTestClient(t *testing.T, expiresIn int, opts ...digikey.ClientOption) (*digikey.Client, *digikeytest.Server) {
+	t.Helper()
+	srv := digikeytest.NewServer("id", "secret")
+	t.Cleanup(srv.Close)
+	srv.Tokens.SetExpiresIn(expiresIn)
+	c, err := srv.NewClient("id", "secret", opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { c.Close() })
+	return c, srv
+}
+
+// lookup makes an API request and returns the access token it was sent with.
+func lookup(t *testing.T, c *digikey.Client, srv *digikeytest.Server) (string, error) {
+	t.Helper()
+	if _, err := c.Products.ProductDetails(context.Background(), "LM358DR"); err != nil {
+		return "", err
+	}
+	reqs := srv.Requests()
+	return reqs[len(reqs)-1].Header.Get("Authorization"), nil
+}
+
+func TestTokenRefreshMargin(t *testing.T) {
+	c, srv := newTokenTestClient(t, 600, digikey.WithTokenRefreshMargin(time.Minute))
+	if got := c.TokenExpiresAt(); time.Until(got) < 9*time.Minute {
+		t.Errorf("TokenExpiresAt() = %s, want about ten minutes from now", got)
+	}
+
+	// Outside the margin the token is reused.
+	c.SetTokenExpiry(2 * time.Minute)
+	if token, err := lookup(t, c, srv); err != nil || token != "Bearer token-1" || srv.Tokens.Requests() != 1 {
+		t.Errorf("request sent %q, %v after %d token requests, want token-1 reused", token, err, srv.Tokens.Requests())
+	}
+	// Within it the token is renewed before it expires.
+	c.SetTokenExpiry(30 * time.Second)
+	if token, err := lookup(t, c, srv); err != nil || token != "Bearer token-2" {
+		t.Errorf("request within the margin sent %q, %v, want token-2", token, err)
+	}
+	// An expired token is renewed.
+	digikeytest.ExpireToken(c)
+	if token, err := lookup(t, c, srv); err != nil || token != "Bearer token-3" {
+		t.Errorf("request after ExpireToken sent %q, %v, want token-3", token, err)
+	}
+
+	// The margin is at most half the lifetime of a short-lived token.
+	c, srv = newTokenTestClient(t, 60, digikey.WithTokenRefreshMargin(time.Minute))
+	c.SetTokenExpiry(45 * time.Second)
+	if _, err := lookup(t, c, srv); err != nil || srv.Tokens.Requests() != 1 {
+		t.Errorf("short-lived token renewed with %s left, want renewal only in its last 30s", 45*time.Second)
+	}
+}
+
+func TestTokenBackoff(t *testing.T) {
+	c, srv := newTokenTestClient(t, 600, digikey.WithTokenBackoff(time.Minute, 4*time.Minute))
+	srv.Tokens.RotateSecret("rotated")
+	digikeytest.ExpireToken(c)
+
+	_, err := lookup(t, c, srv)
+	var tokenErr *digikey.TokenError
+	if !errors.As(err, &tokenErr) || tokenErr.Failures != 1 || srv.Tokens.Requests() != 2 {
+		t.Fatalf("request = %v after %d token requests, want a TokenError after 2", err, srv.Tokens.Requests())
+	}
+	if wait := time.Until(tokenErr.RetryAt); wait < 29*time.Second || wait > time.Minute {
+		t.Errorf("first retry after %s, want between 30s and 1m", wait)
+	}
+
+	// Until RetryAt, requests fail fast with the same error.
+	srv.Tokens.RotateSecret("secret")
+	n := len(srv.Requests())
+	_, err = lookup(t, c, srv)
+	var again *digikey.TokenError
+	if !errors.As(err, &again) || again != tokenErr || srv.Tokens.Requests() != 2 || len(srv.Requests()) != n {
+		t.Errorf("request during backoff = %v after %d token requests, want the same error without a request", err, srv.Tokens.Requests())
+	}
+
+	// After RetryAt the token is requested again.
+	c.EndTokenBackoff()
+	if token, err := lookup(t, c, srv); err != nil || token != "Bearer token-2" {
+		t.Errorf("request after backoff sent %q, %v, want token-2", token, err)
+	}
+
+	for _, tt := range []struct {
+		failures int
+		min, max time.Duration
+	}{
+		{1, 30 * time.Second, time.Minute},
+		{2, time.Minute, 2 * time.Minute},
+		{3, 2 * time.Minute, 4 * time.Minute},
+		{10, 2 * time.Minute, 4 * time.Minute},
+	} {
+		if d := c.TokenBackoffDelay(tt.failures); d < tt.min || d > tt.max {
+			t.Errorf("TokenBackoffDelay(%d) = %s, want between %s and %s", tt.failures, d, tt.min, tt.max)
+		}
+	}
+}
+
+func TestTokenRefreshCoalesces(t *testing.T) {
+	c, srv := newTokenTestClient(t, 600)
+	srv.Tokens.SetDelay(50 * time.Millisecond)
+	digikeytest.ExpireToken(c)
+
+	var wg sync.WaitGroup
+	for range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.Products.ProductDetails(context.Background(), "LM358DR"); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	if srv.Tokens.Requests() != 2 {
+		t.Errorf("%d token requests for concurrent callers, want 1 after the first token", srv.Tokens.Requests()-1)
+	}
+	for _, req := range srv.Requests() {
+		if token := req.Header.Get("Authorization"); token != "Bearer token-2" {
+			t.Errorf("concurrent caller sent %q, want token-2", token)
+		}
+	}
+}
+
+func TestTokenRefresherClose(t *testing.T) {
+	// A token expiring within the window is refreshed as soon as the
+	// refresher's minimum interval allows.
+	c, srv := newTokenTestClient(t, 1, digikey.WithTokenRefresher(time.Minute))
+	srv.Tokens.SetDelay(time.Hour)
+	for deadline := time.Now().Add(5 * time.Second); srv.Tokens.Requests() < 2; time.Sleep(10 * time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatal("refresher did not request a token")
+		}
+	}
+
+	// Close cancels the hanging request and waits for the refresher.
+	done := make(chan struct{})
+	go func() {
+		c.Close()
+		c.Close()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Close did not stop the refresher")
+	}
+
+	// The cancelled refresh is not a failure for requests to back off from.
+	srv.Tokens.SetDelay(0)
+	if _, err := lookup(t, c, srv); err != nil {
+		t.Errorf("request after Close = %v, want a new token", err)
+	}
+}
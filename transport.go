@@ -0,0 +1,190 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// ErrCustomTransport is returned by NewClient when transport options, such
+// as WithProxy, are combined with an HTTP client whose transport is not an
+// *http.Transport, which they cannot configure.
+var ErrCustomTransport = errors.New("transport options require an *http.Transport")
+
+// WithProxy sends requests, including token requests, through the HTTP or
+// HTTPS proxy at the URL, e.g. "http://proxy.example.com:3128". An empty
+// URL uses the proxy given by the HTTP_PROXY, HTTPS_PROXY, and NO_PROXY
+// environment variables, as the default transport does, which is useful
+// with a custom transport.
+func WithProxy(proxyURL string) ClientOption {
+	return func(client *Client) {
+		if proxyURL == "" {
+			client.transportOpts = append(client.transportOpts, func(t *http.Transport) {
+				t.Proxy = http.ProxyFromEnvironment
+			})
+			return
+		}
+		u, err := url.Parse(proxyURL)
+		if err == nil && u.Host == "" {
+			err = errors.New("missing host")
+		}
+		if err != nil {
+			client.optErr = errors.Join(client.optErr, fmt.Errorf("error parsing proxy URL %q: %w", proxyURL, err))
+			return
+		}
+		client.transportOpts = append(client.transportOpts, func(t *http.Transport) {
+			t.Proxy = http.ProxyURL(u)
+		})
+	}
+}
+
+// WithTLSConfig sets the TLS configuration of API and token requests, e.g.
+// to trust the CA of a TLS-intercepting proxy:
+//
+//	pool, _ := x509.SystemCertPool()
+//	pool.AppendCertsFromPEM(proxyCA)
+//	c, err := digikey.NewClient(id, secret, digikey.WithTLSConfig(&tls.Config{RootCAs: pool}))
+//
+// The configuration is cloned, so later changes to it have no effect.
+func WithTLSConfig(config *tls.Config) ClientOption {
+	config = config.Clone()
+	return func(client *Client) {
+		client.transportOpts = append(client.transportOpts, func(t *http.Transport) {
+			t.TLSClientConfig = config
+		})
+	}
+}
+
+// WithTimeouts sets the timeouts of API and token requests: for connecting,
+// for the TLS handshake, for the response headers after the request is
+// sent, and overall, including reading the body, which defaults to 60
+// seconds. A zero duration leaves that timeout unchanged and a negative one
+// removes it, e.g. to fail fast on an unreachable network but allow large
+// downloads as long as they keep going:
+//
+//	digikey.WithTimeouts(5*time.Second, 5*time.Second, 30*time.Second, -1)
+func WithTimeouts(connect, tlsHandshake, responseHeader, overall time.Duration) ClientOption {
+	return func(client *Client) {
+		if connect != 0 || tlsHandshake != 0 || responseHeader != 0 {
+			client.transportOpts = append(client.transportOpts, func(t *http.Transport) {
+				if connect != 0 {
+					t.DialContext = (&net.Dialer{
+						Timeout:   max(connect, 0),
+						KeepAlive: 30 * time.Second,
+					}).DialContext
+				}
+				if tlsHandshake != 0 {
+					t.TLSHandshakeTimeout = max(tlsHandshake, 0)
+				}
+				if responseHeader != 0 {
+					t.ResponseHeaderTimeout = max(responseHeader, 0)
+				}
+			})
+		}
+		if overall != 0 {
+			client.httpClientOpts = append(client.httpClientOpts, func(hc *http.Client) {
+				hc.Timeout = max(overall, 0)
+			})
+		}
+	}
+}
+
+// WithTransportTuning sets the connection pool of the transport: the
+// maximum number of idle connections overall and per host, and how long an
+// idle connection is kept. High-throughput jobs, such as enriching a large
+// BOM with WithConcurrency, should allow at least as many idle connections
+// per host as requests in flight, so that connections are reused rather
+// than opened for each request. Zero values leave the transport's setting
+// unchanged.
+func WithTransportTuning(maxIdleConns, maxIdleConnsPerHost int, idleConnTimeout time.Duration) ClientOption {
+	return func(client *Client) {
+		client.transportOpts = append(client.transportOpts, func(t *http.Transport) {
+			if maxIdleConns != 0 {
+				t.MaxIdleConns = maxIdleConns
+			}
+			if maxIdleConnsPerHost != 0 {
+				t.MaxIdleConnsPerHost = maxIdleConnsPerHost
+			}
+			if idleConnTimeout != 0 {
+				t.IdleConnTimeout = idleConnTimeout
+			}
+		})
+	}
+}
+
+// configureTransport applies the transport and HTTP client options to a
+// copy of the HTTP client and a clone of its transport, so a client or
+// transport shared with other code is left as is.
+func (c *Client) configureTransport() error {
+	if len(c.transportOpts) == 0 && len(c.httpClientOpts) == 0 {
+		return nil
+	}
+	hc := *c.httpClient
+	if len(c.transportOpts) > 0 {
+		base := hc.Transport
+		if base == nil {
+			base = http.DefaultTransport
+		}
+		t, ok := base.(*http.Transport)
+		if !ok {
+			return ErrCustomTransport
+		}
+		t = t.Clone()
+		for _, opt := range c.transportOpts {
+			opt(t)
+		}
+		hc.Transport = t
+	}
+	for _, opt := range c.httpClientOpts {
+		opt(&hc)
+	}
+	c.httpClient = &hc
+	return nil
+}
+
+// authTransport is an http.RoundTripper that attaches the client's access
+// token and client ID to each request, refreshing the token under the
+// client's lock as needed, so no request is sent with a stale token.
+type authTransport struct {
+	client *Client
+	base   http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.client.getAccessToken(req.Context())
+	if err != nil {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, err
+	}
+	// A RoundTripper must not modify the request it is given.
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("X-DIGIKEY-Client-Id", t.client.id)
+	return t.base.RoundTrip(req)
+}
+
+// authenticatedClient returns a copy of the client's HTTP client whose
+// transport attaches the DigiKey authorization headers. The HTTP client
+// itself is left unauthenticated, for token requests and downloads from
+// other hosts.
+func (c *Client) authenticatedClient() *http.Client {
+	hc := *c.httpClient
+	base := hc.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	hc.Transport = &authTransport{client: c, base: base}
+	return &hc
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import (
+	"runtime"
+	"runtime/debug"
+	"strings"
+	"sync"
+)
+
+const modulePath = "github.com/apidepot/digikey"
+
+// DefaultUserAgent returns the User-Agent sent unless changed with
+// WithUserAgent, e.g. "apidepot-digikey/v1.2.0 Go/1.24.2". The version is
+// the module version the program was built with, or "devel" if unknown.
+func DefaultUserAgent() string {
+	return defaultUserAgent()
+}
+
+var defaultUserAgent = sync.OnceValue(func() string {
+	return "apidepot-digikey/" + moduleVersion() + " Go/" + strings.TrimPrefix(runtime.Version(), "go")
+})
+
+// moduleVersion returns the version of this module in the build.
+func moduleVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "devel"
+	}
+	if info.Main.Path == modulePath && info.Main.Version != "" && info.Main.Version != "(devel)" {
+		return info.Main.Version
+	}
+	for _, dep := range info.Deps {
+		if dep.Path == modulePath {
+			if dep.Replace != nil && dep.Replace.Version != "" {
+				return dep.Replace.Version
+			}
+			return dep.Version
+		}
+	}
+	return "devel"
+}
+
+// WithUserAgent sets the User-Agent header of API and token requests, e.g.
+// to identify the application using the package. A User-Agent set on a
+// single request with WithRequestHeader takes precedence.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(client *Client) {
+		client.userAgent = userAgent
+	}
+}
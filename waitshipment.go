@@ -0,0 +1,113 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package digikey
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+)
+
+// DefaultShipmentPollInterval is how often WaitForShipment polls an order
+// when given a zero interval.
+const DefaultShipmentPollInterval = 15 * time.Minute
+
+// ShipmentProgress reports the state of an order being waited on by
+// WaitForShipment.
+type ShipmentProgress struct {
+	// Order is the order as of the latest successful poll.
+	Order *SalesOrder
+	// Lines and LinesShipped are the number of lines of the order and of
+	// those shipped in full.
+	Lines        int
+	LinesShipped int
+	// QuantityOrdered and QuantityShipped are the quantities of all lines.
+	QuantityOrdered int
+	QuantityShipped int
+	// NewlyShipped are the lines whose shipped quantity grew since the
+	// previous poll.
+	NewlyShipped []LineItem
+	// Polls is the number of polls so far, including failed ones.
+	Polls int
+	// Err is the error of a failed poll, which is retried at the next
+	// interval. Order and the counts are those of the last successful poll.
+	Err error
+}
+
+// WaitForShipment polls the status of the sales order every pollInterval,
+// or DefaultShipmentPollInterval if it is zero, until every line has
+// shipped, and returns the shipped order.
+//
+// If progress is not nil, it is called after the first poll, whenever the
+// shipped quantities change, and after a poll fails. A poll failing with a
+// server error or because the API is throttling requests is retried at the
+// next interval; any other error, or the context ending, stops the wait
+// and is returned along with the order as of the last successful poll.
+func (s *OrdersService) WaitForShipment(ctx context.Context, salesOrderID int, pollInterval time.Duration, progress func(ShipmentProgress), opts ...RequestOption) (*SalesOrder, error) {
+	if pollInterval <= 0 {
+		pollInterval = DefaultShipmentPollInterval
+	}
+	var p ShipmentProgress
+	shipped := make(map[int]int)
+	for {
+		p.Polls++
+		order, err := s.Status(ctx, salesOrderID, opts...)
+		switch {
+		case err == nil:
+			p.Err = nil
+			p.NewlyShipped = nil
+			changed := p.Order == nil
+			p.Order = order
+			p.Lines, p.LinesShipped = len(order.LineItems), 0
+			p.QuantityOrdered, p.QuantityShipped = 0, 0
+			for _, l := range order.LineItems {
+				p.QuantityOrdered += l.QuantityOrdered
+				p.QuantityShipped += l.QuantityShipped
+				if l.Shipped() {
+					p.LinesShipped++
+				}
+				if l.QuantityShipped > shipped[l.DetailID] {
+					p.NewlyShipped = append(p.NewlyShipped, l)
+					changed = true
+				}
+				shipped[l.DetailID] = l.QuantityShipped
+			}
+			if progress != nil && changed {
+				progress(p)
+			}
+			// An order without lines has not been processed yet.
+			if len(order.LineItems) > 0 && order.Shipped() {
+				return order, nil
+			}
+		case ctx.Err() != nil || !retryablePollError(err):
+			return p.Order, err
+		default:
+			p.Err = err
+			if progress != nil {
+				progress(p)
+			}
+		}
+
+		t := time.NewTimer(pollInterval)
+		select {
+		case <-t.C:
+		case <-ctx.Done():
+			t.Stop()
+			return p.Order, ctx.Err()
+		}
+	}
+}
+
+// retryablePollError reports whether a failed poll may succeed if tried
+// again later.
+func retryablePollError(err error) bool {
+	var apiErr Error
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode >= 500 || apiErr.StatusCode == http.StatusTooManyRequests
+	}
+	return errors.Is(err, ErrQuotaExceeded) || errors.Is(err, ErrCircuitOpen)
+}
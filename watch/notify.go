@@ -0,0 +1,210 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package watch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+// Notifier delivers watch events to people or other systems.
+type Notifier interface {
+	Notify(ctx context.Context, e Event) error
+}
+
+// NotifierFunc adapts a function to the Notifier interface.
+type NotifierFunc func(ctx context.Context, e Event) error
+
+// Notify implements Notifier.
+func (f NotifierFunc) Notify(ctx context.Context, e Event) error {
+	return f(ctx, e)
+}
+
+// WithNotifier adds a notifier each event is delivered to. Failed
+// deliveries are passed to onError, if it is not nil, and do not stop the
+// watcher.
+func WithNotifier(n Notifier, onError func(Event, error)) Option {
+	return func(w *Watcher) {
+		w.notifiers = append(w.notifiers, notifier{n, onError})
+	}
+}
+
+// notifier is a notifier and its error handler.
+type notifier struct {
+	Notifier
+	onError func(Event, error)
+}
+
+// String returns a one-line summary of the event, as used in notifications.
+func (e Event) String() string {
+	name := e.PartNumber
+	if e.Product != nil && e.Product.ManufacturerProductNumber != "" {
+		name = e.Product.ManufacturerProductNumber
+	}
+	switch e.Type {
+	case EventError:
+		return fmt.Sprintf("%s: poll failed: %v", name, e.Err)
+	case EventInStock:
+		return fmt.Sprintf("%s is back in stock: %d available", name, e.Current)
+	case EventOutOfStock:
+		return fmt.Sprintf("%s is out of stock", name)
+	case EventBelowThreshold:
+		return fmt.Sprintf("%s stock dropped from %d to %d", name, e.Previous, e.Current)
+	case EventPriceChanged, EventPriceBelow, EventPriceAbove:
+		return fmt.Sprintf("%s price %s: %s to %s", name, priceVerb(e.Type), e.PreviousPrice, e.Price)
+	}
+	return fmt.Sprintf("%s: %s", name, e.Type)
+}
+
+func priceVerb(t EventType) string {
+	switch t {
+	case EventPriceBelow:
+		return "dropped below threshold"
+	case EventPriceAbove:
+		return "rose above threshold"
+	}
+	return "changed"
+}
+
+// EventPayload is the JSON representation of an event posted by
+// WebhookNotifier.
+type EventPayload struct {
+	Type                      string    `json:"type"`
+	PartNumber                string    `json:"partNumber"`
+	ManufacturerProductNumber string    `json:"manufacturerProductNumber,omitempty"`
+	Manufacturer              string    `json:"manufacturer,omitempty"`
+	ProductURL                string    `json:"productUrl,omitempty"`
+	Previous                  int       `json:"previous"`
+	Current                   int       `json:"current"`
+	PreviousPrice             float64   `json:"previousPrice,omitempty"`
+	Price                     float64   `json:"price,omitempty"`
+	Currency                  string    `json:"currency,omitempty"`
+	Time                      time.Time `json:"time"`
+	Message                   string    `json:"message"`
+	Error                     string    `json:"error,omitempty"`
+}
+
+// NewEventPayload returns the JSON representation of the event.
+func NewEventPayload(e Event) EventPayload {
+	p := EventPayload{
+		Type:          e.Type.String(),
+		PartNumber:    e.PartNumber,
+		Previous:      e.Previous,
+		Current:       e.Current,
+		PreviousPrice: e.PreviousPrice.Amount,
+		Price:         e.Price.Amount,
+		Currency:      e.Price.Currency,
+		Time:          e.Time,
+		Message:       e.String(),
+	}
+	if e.Product != nil {
+		p.ManufacturerProductNumber = e.Product.ManufacturerProductNumber
+		p.Manufacturer = e.Product.Manufacturer.Name
+		p.ProductURL = e.Product.ProductURL
+	}
+	if e.Err != nil {
+		p.Error = e.Err.Error()
+	}
+	return p
+}
+
+// WebhookNotifier posts each event as an EventPayload JSON object to a URL.
+type WebhookNotifier struct {
+	URL string
+	// Client sends the requests, or http.DefaultClient if nil.
+	Client *http.Client
+	// Header is added to each request, e.g. for authorization.
+	Header http.Header
+}
+
+// Notify implements Notifier.
+func (n *WebhookNotifier) Notify(ctx context.Context, e Event) error {
+	return postJSON(ctx, n.Client, n.URL, n.Header, NewEventPayload(e))
+}
+
+// SlackNotifier posts each event to a Slack incoming webhook.
+type SlackNotifier struct {
+	WebhookURL string
+	// Client sends the requests, or http.DefaultClient if nil.
+	Client *http.Client
+}
+
+// Notify implements Notifier.
+func (n *SlackNotifier) Notify(ctx context.Context, e Event) error {
+	text := e.String()
+	if e.Product != nil && e.Product.ProductURL != "" {
+		text = fmt.Sprintf("%s <%s|View on DigiKey>", text, e.Product.ProductURL)
+	}
+	return postJSON(ctx, n.Client, n.WebhookURL, nil, map[string]string{"text": text})
+}
+
+// SMTPNotifier emails each event.
+type SMTPNotifier struct {
+	// Addr is the host:port of the SMTP server.
+	Addr string
+	// Auth authenticates with the server, if not nil.
+	Auth smtp.Auth
+	From string
+	To   []string
+}
+
+// Notify implements Notifier. The context is not used, since net/smtp does
+// not support cancellation.
+func (n *SMTPNotifier) Notify(_ context.Context, e Event) error {
+	var msg strings.Builder
+	fmt.Fprintf(&msg, "From: %s\r\n", n.From)
+	fmt.Fprintf(&msg, "To: %s\r\n", strings.Join(n.To, ", "))
+	// Error messages may span lines, which would corrupt the header.
+	subject := strings.NewReplacer("\r", " ", "\n", " ").Replace(e.String())
+	fmt.Fprintf(&msg, "Subject: [DigiKey] %s\r\n", subject)
+	fmt.Fprintf(&msg, "Date: %s\r\n", e.Time.Format(time.RFC1123Z))
+	msg.WriteString("Content-Type: text/plain; charset=utf-8\r\n\r\n")
+	fmt.Fprintf(&msg, "%s\r\n", e)
+	if e.Product != nil && e.Product.ProductURL != "" {
+		fmt.Fprintf(&msg, "\r\n%s\r\n", e.Product.ProductURL)
+	}
+	if err := smtp.SendMail(n.Addr, n.Auth, n.From, n.To, []byte(msg.String())); err != nil {
+		return fmt.Errorf("error sending email: %w", err)
+	}
+	return nil
+}
+
+// postJSON posts v as JSON to the URL, treating any status other than 2xx
+// as an error.
+func postJSON(ctx context.Context, client *http.Client, url string, header http.Header, v any) error {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	body, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("error marshaling notification: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	for k, vs := range header {
+		req.Header[k] = vs
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("error posting notification: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("error posting notification: %s: %s", resp.Status, msg)
+	}
+	return nil
+}
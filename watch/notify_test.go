@@ -0,0 +1,100 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package watch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/apidepot/digikey"
+)
+
+func TestWebhookNotifier(t *testing.T) {
+	var got []EventPayload
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/json" || r.Header.Get("Authorization") != "Bearer hook" {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		var p EventPayload
+		if err := json.NewDecoder(r.Body).Decode(&p); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		got = append(got, p)
+	}))
+	defer srv.Close()
+	n := &WebhookNotifier{URL: srv.URL, Header: http.Header{"Authorization": {"Bearer hook"}}}
+
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	e := Event{
+		Type:       EventInStock,
+		PartNumber: "296-1395-1-ND",
+		Product: &digikey.Product{
+			ManufacturerProductNumber: "LM358DR",
+			Manufacturer:              digikey.Manufacturer{Name: "Texas Instruments"},
+			ProductURL:                "https://www.digikey.com/en/products/detail/LM358DR",
+		},
+		Current: 1200,
+		Time:    now,
+	}
+	if err := n.Notify(context.Background(), e); err != nil {
+		t.Fatal(err)
+	}
+	want := EventPayload{
+		Type:                      "InStock",
+		PartNumber:                "296-1395-1-ND",
+		ManufacturerProductNumber: "LM358DR",
+		Manufacturer:              "Texas Instruments",
+		ProductURL:                "https://www.digikey.com/en/products/detail/LM358DR",
+		Current:                   1200,
+		Time:                      now,
+		Message:                   "LM358DR is back in stock: 1200 available",
+	}
+	if len(got) != 1 || got[0] != want {
+		t.Errorf("posted %+v, want %+v", got, want)
+	}
+
+	// A failed delivery is an error passed to the watcher's handler.
+	n.Header = nil
+	if err := n.Notify(context.Background(), e); err == nil || !strings.Contains(err.Error(), "400") {
+		t.Errorf("Notify() rejected by the server = %v, want a 400 error", err)
+	}
+	var failed []Event
+	w := New(nil, WithNotifier(n, func(e Event, err error) { failed = append(failed, e) }))
+	if err := w.emit(context.Background(), []Event{e}); err != nil || len(failed) != 1 || failed[0].PartNumber != e.PartNumber {
+		t.Errorf("emit() to a failing notifier = %v with failures %v, want the event passed to onError", err, failed)
+	}
+}
+
+func TestSlackNotifier(t *testing.T) {
+	var text string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		json.NewDecoder(r.Body).Decode(&body)
+		text = body["text"]
+	}))
+	defer srv.Close()
+	n := &SlackNotifier{WebhookURL: srv.URL}
+	e := Event{
+		Type:          EventPriceBelow,
+		PartNumber:    "LM358DR",
+		Product:       &digikey.Product{ProductURL: "https://www.digikey.com/p"},
+		PreviousPrice: digikey.NewMoney(0.5, "USD"),
+		Price:         digikey.NewMoney(0.4, "USD"),
+	}
+	if err := n.Notify(context.Background(), e); err != nil {
+		t.Fatal(err)
+	}
+	if want := "LM358DR price dropped below threshold: 0.5 USD to 0.4 USD <https://www.digikey.com/p|View on DigiKey>"; text != want {
+		t.Errorf("posted %q, want %q", text, want)
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package watch
+
+import (
+	"math"
+	"time"
+
+	"github.com/apidepot/digikey"
+)
+
+// DefaultHysteresis is the fraction by which a price must recross its
+// threshold before the crossing is reported again, unless changed with
+// Hysteresis.
+const DefaultHysteresis = 0.02
+
+// priceWatch is the price tracking state of a watched part.
+type priceWatch struct {
+	qty        int
+	change     float64
+	threshold  float64
+	hysteresis float64
+
+	known     bool
+	reference digikey.Money // price at the last EventPriceChanged
+	last      digikey.Money // price at the previous poll
+	below     bool
+}
+
+// PriceAt tracks the part's unit price at the given order quantity, which is
+// the lowest price of its packaging options that can be ordered at qty.
+// Price events are only reported for parts with a tracked quantity.
+func PriceAt(qty int) WatchOption {
+	return func(p *part) {
+		p.price.qty = qty
+	}
+}
+
+// PriceChange reports EventPriceChanged when the unit price differs from
+// the price last reported, or first observed, by more than pct percent.
+// Measuring from the last reported price rather than the previous poll
+// means a series of small changes is reported once it adds up.
+func PriceChange(pct float64) WatchOption {
+	return func(p *part) {
+		p.price.change = pct / 100
+	}
+}
+
+// PriceThreshold reports EventPriceBelow when the unit price drops below
+// price and EventPriceAbove when it rises back above it by more than the
+// hysteresis. The price is in the currency of the responses.
+func PriceThreshold(price float64) WatchOption {
+	return func(p *part) {
+		p.price.threshold = price
+	}
+}
+
+// Hysteresis sets the percentage by which a price must move back past its
+// threshold before another crossing is reported, so a price hovering around
+// the threshold does not flap between EventPriceBelow and EventPriceAbove.
+func Hysteresis(pct float64) WatchOption {
+	return func(p *part) {
+		p.price.hysteresis = pct / 100
+	}
+}
+
+// detect returns the price events caused by the product's new state. The
+// first observation only records the baseline, as does the first in a new
+// currency, since prices in different currencies can't be compared.
+func (w *priceWatch) detect(pn string, product *digikey.Product, polled bool, now time.Time) []Event {
+	if w.qty <= 0 {
+		return nil
+	}
+	price, ok := unitPrice(product, w.qty)
+	if !ok {
+		return nil
+	}
+	if !polled || !w.known || price.Currency != w.reference.Currency {
+		w.known = true
+		w.reference, w.last = price, price
+		w.below = w.threshold > 0 && price.Amount < w.threshold
+		return nil
+	}
+	last := w.last
+	w.last = price
+
+	event := func(t EventType, prev digikey.Money) Event {
+		return Event{
+			Type:          t,
+			PartNumber:    pn,
+			Product:       product,
+			Previous:      product.QuantityAvailable,
+			Current:       product.QuantityAvailable,
+			PreviousPrice: prev,
+			Price:         price,
+			Time:          now,
+		}
+	}
+	var events []Event
+	if ref := w.reference.Amount; w.change > 0 && ref > 0 && math.Abs(price.Amount-ref)/ref > w.change {
+		events = append(events, event(EventPriceChanged, w.reference))
+		w.reference = price
+	}
+	if w.threshold > 0 {
+		switch {
+		case !w.below && price.Amount < w.threshold:
+			w.below = true
+			events = append(events, event(EventPriceBelow, last))
+		case w.below && price.Amount > w.threshold*(1+w.hysteresis):
+			w.below = false
+			events = append(events, event(EventPriceAbove, last))
+		}
+	}
+	return events
+}
+
+// unitPrice returns the lowest unit price of the product's packaging options
+// that can be ordered at qty, falling back to the product's unit price if it
+// has none.
+func unitPrice(product *digikey.Product, qty int) (digikey.Money, bool) {
+	var best digikey.Money
+	found := false
+	for _, v := range product.ProductVariations {
+		if qty < v.MinimumOrderQuantity {
+			continue
+		}
+		if price, ok := digikey.UnitPriceAt(v.StandardPricing, qty); ok && (!found || price.Amount < best.Amount) {
+			best, found = price, true
+		}
+	}
+	if !found && product.UnitPrice.Amount > 0 {
+		return product.UnitPrice, true
+	}
+	return best, found
+}
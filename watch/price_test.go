@@ -0,0 +1,84 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package watch
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/apidepot/digikey"
+)
+
+func TestPriceEvents(t *testing.T) {
+	w, srv, events := newTestWatcher(t)
+	w.Watch("PART", PriceAt(1), PriceChange(10), PriceThreshold(1), Hysteresis(5))
+	for _, tt := range []struct {
+		price float64
+		want  []EventType
+		prev  []float64
+	}{
+		{1.20, nil, nil}, // the first poll records the baseline
+		{1.15, nil, nil},
+		// Small changes add up, measured from the baseline.
+		{0.99, []EventType{EventPriceChanged, EventPriceBelow}, []float64{1.20, 1.15}},
+		// Recrossing the threshold within the hysteresis is not reported.
+		{1.02, nil, nil},
+		{0.98, nil, nil},
+		{1.06, []EventType{EventPriceAbove}, []float64{0.98}},
+		{1.20, []EventType{EventPriceChanged}, []float64{0.99}},
+	} {
+		setPart(srv, 100, tt.price)
+		var types []EventType
+		var prev []float64
+		for _, e := range pollNow(t, w, events) {
+			types = append(types, e.Type)
+			prev = append(prev, e.PreviousPrice.Amount)
+			if e.Price.Amount != tt.price {
+				t.Errorf("price %g: %s event with price %s", tt.price, e.Type, e.Price)
+			}
+		}
+		if !slices.Equal(types, tt.want) || !slices.Equal(prev, tt.prev) {
+			t.Errorf("price %g: events %v from %v, want %v from %v", tt.price, types, prev, tt.want, tt.prev)
+		}
+	}
+}
+
+func TestUnitPrice(t *testing.T) {
+	product := &digikey.Product{
+		UnitPrice: digikey.NewMoney(0.5, "USD"),
+		ProductVariations: []digikey.ProductVariation{
+			{
+				MinimumOrderQuantity: 1,
+				StandardPricing: []digikey.PriceBreak{
+					{BreakQuantity: 1, UnitPrice: digikey.NewMoney(0.4, "USD")},
+					{BreakQuantity: 100, UnitPrice: digikey.NewMoney(0.2, "USD")},
+				},
+			},
+			{
+				MinimumOrderQuantity: 2500,
+				StandardPricing: []digikey.PriceBreak{
+					{BreakQuantity: 2500, UnitPrice: digikey.NewMoney(0.1, "USD")},
+				},
+			},
+		},
+	}
+	for _, tt := range []struct {
+		qty  int
+		want float64
+	}{
+		{1, 0.4},
+		{100, 0.2},
+		{2500, 0.1},
+	} {
+		if got, ok := unitPrice(product, tt.qty); !ok || got.Amount != tt.want {
+			t.Errorf("unitPrice(%d) = %s, %t, want %g", tt.qty, got, ok, tt.want)
+		}
+	}
+	product.ProductVariations = nil
+	if got, ok := unitPrice(product, 10); !ok || got.Amount != 0.5 {
+		t.Errorf("unitPrice() without variations = %s, %t, want the product's unit price", got, ok)
+	}
+}
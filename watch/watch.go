@@ -0,0 +1,364 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+// Package watch monitors DigiKey products, polling them periodically and
+// reporting changes in stock and price as events.
+package watch
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/apidepot/digikey"
+)
+
+// DefaultInterval is the poll interval of watched parts, unless changed with
+// WithInterval or Interval.
+const DefaultInterval = 15 * time.Minute
+
+// EventType is the kind of change an event reports.
+type EventType int
+
+// Event types.
+const (
+	// EventError reports a failed poll.
+	EventError EventType = iota
+	// EventInStock reports a part coming back into stock.
+	EventInStock
+	// EventOutOfStock reports a part going out of stock.
+	EventOutOfStock
+	// EventBelowThreshold reports a part's stock dropping below its
+	// threshold without running out.
+	EventBelowThreshold
+	// EventPriceChanged reports a part's unit price changing by more than
+	// its configured percentage.
+	EventPriceChanged
+	// EventPriceBelow reports a part's unit price dropping below its price
+	// threshold.
+	EventPriceBelow
+	// EventPriceAbove reports a part's unit price rising back above its
+	// price threshold.
+	EventPriceAbove
+)
+
+var eventTypeNames = map[EventType]string{
+	EventError:          "Error",
+	EventInStock:        "InStock",
+	EventOutOfStock:     "OutOfStock",
+	EventBelowThreshold: "BelowThreshold",
+	EventPriceChanged:   "PriceChanged",
+	EventPriceBelow:     "PriceBelow",
+	EventPriceAbove:     "PriceAbove",
+}
+
+// String implements fmt.Stringer.
+func (t EventType) String() string {
+	return eventTypeNames[t]
+}
+
+// Event is a change observed in a watched part. Product is nil for
+// EventError.
+type Event struct {
+	Type       EventType
+	PartNumber string
+	Product    *digikey.Product
+	// Previous and Current are the quantities available at the previous
+	// and current polls.
+	Previous int
+	Current  int
+	// PreviousPrice and Price are the unit prices at the watched quantity
+	// that a price event compares: the last reported price for
+	// EventPriceChanged and the previous poll's price otherwise.
+	PreviousPrice digikey.Money
+	Price         digikey.Money
+	Time          time.Time
+	Err           error
+}
+
+// Watcher polls watched parts and reports changes to its handlers. Polls are
+// made one at a time through the client, so they are subject to its rate
+// limiter and quota, and poll less often while the quota is exhausted or the
+// API is throttling requests.
+type Watcher struct {
+	client      *digikey.Client
+	interval    time.Duration
+	handlers    []func(Event)
+	channels    []chan<- Event
+	notifiers   []notifier
+	requestOpts []digikey.RequestOption
+
+	mu    sync.Mutex
+	parts map[string]*part
+	wake  chan struct{}
+}
+
+// part is the state of a watched part.
+type part struct {
+	interval  time.Duration
+	threshold int
+	next      time.Time
+	polled    bool
+	available int
+	price     priceWatch
+}
+
+// Option applies an option to a watcher.
+type Option func(*Watcher)
+
+// WithInterval sets the default poll interval.
+func WithInterval(d time.Duration) Option {
+	return func(w *Watcher) {
+		w.interval = d
+	}
+}
+
+// WithHandler adds a function called with each event. Handlers are called
+// synchronously by Run, so a slow handler delays subsequent polls.
+func WithHandler(h func(Event)) Option {
+	return func(w *Watcher) {
+		w.handlers = append(w.handlers, h)
+	}
+}
+
+// WithChannel adds a channel each event is sent to. Run blocks until the
+// event is received or its context is cancelled.
+func WithChannel(ch chan<- Event) Option {
+	return func(w *Watcher) {
+		w.channels = append(w.channels, ch)
+	}
+}
+
+// WithRequestOptions sets request options applied to every poll. Polls
+// always bypass cached responses, though fresh ones are stored in the cache.
+func WithRequestOptions(opts ...digikey.RequestOption) Option {
+	return func(w *Watcher) {
+		w.requestOpts = append(w.requestOpts, opts...)
+	}
+}
+
+// New creates a watcher that polls parts using the client.
+func New(c *digikey.Client, opts ...Option) *Watcher {
+	w := &Watcher{
+		client:   c,
+		interval: DefaultInterval,
+		parts:    make(map[string]*part),
+		wake:     make(chan struct{}, 1),
+	}
+	for _, opt := range opts {
+		opt(w)
+	}
+	w.requestOpts = append(w.requestOpts, digikey.ForceRefresh())
+	return w
+}
+
+// WatchOption applies an option to a watched part.
+type WatchOption func(*part)
+
+// Interval sets the poll interval of the part.
+func Interval(d time.Duration) WatchOption {
+	return func(p *part) {
+		p.interval = d
+	}
+}
+
+// Threshold reports EventBelowThreshold when the part's quantity available
+// drops below n.
+func Threshold(n int) WatchOption {
+	return func(p *part) {
+		p.threshold = n
+	}
+}
+
+// Watch starts watching the part with the given DigiKey or manufacturer
+// product number, replacing any previous watch of it. The part is first
+// polled promptly to record its state; events report changes from then on.
+func (w *Watcher) Watch(partNumber string, opts ...WatchOption) {
+	p := &part{
+		interval: w.interval,
+		next:     time.Now(),
+		price:    priceWatch{hysteresis: DefaultHysteresis},
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	w.mu.Lock()
+	w.parts[partNumber] = p
+	w.mu.Unlock()
+	w.notify()
+}
+
+// Unwatch stops watching the part.
+func (w *Watcher) Unwatch(partNumber string) {
+	w.mu.Lock()
+	delete(w.parts, partNumber)
+	w.mu.Unlock()
+}
+
+// Run polls the watched parts as they fall due until the context is
+// cancelled, then returns the context's error.
+func (w *Watcher) Run(ctx context.Context) error {
+	for {
+		next, err := w.poll(ctx)
+		if err != nil {
+			return err
+		}
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		case <-w.wake:
+			timer.Stop()
+		}
+	}
+}
+
+// notify wakes Run to reschedule its polls.
+func (w *Watcher) notify() {
+	select {
+	case w.wake <- struct{}{}:
+	default:
+	}
+}
+
+// poll polls the parts that are due and returns when the next part falls
+// due. It returns an error only if the context is cancelled.
+func (w *Watcher) poll(ctx context.Context) (time.Time, error) {
+	for _, pn := range w.due(time.Now()) {
+		if err := ctx.Err(); err != nil {
+			return time.Time{}, err
+		}
+		product, err := w.client.Products.ProductDetails(ctx, pn, w.requestOpts...)
+		if err != nil && ctx.Err() != nil {
+			return time.Time{}, ctx.Err()
+		}
+		events := w.record(pn, product, err)
+		if err := w.emit(ctx, events); err != nil {
+			return time.Time{}, err
+		}
+		if throttled(err) {
+			w.deferAll()
+			break
+		}
+	}
+	return w.nextDue(), nil
+}
+
+// due returns the parts due to be polled at now.
+func (w *Watcher) due(now time.Time) []string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	var due []string
+	for pn, p := range w.parts {
+		if !p.next.After(now) {
+			due = append(due, pn)
+		}
+	}
+	return due
+}
+
+// nextDue returns when the next part falls due, or one default interval from
+// now if no parts are watched.
+func (w *Watcher) nextDue() time.Time {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	next := time.Now().Add(w.interval)
+	for _, p := range w.parts {
+		if p.next.Before(next) {
+			next = p.next
+		}
+	}
+	return next
+}
+
+// deferAll postpones the parts that are due by a poll interval, after the
+// quota has been exhausted or the API has throttled a request.
+func (w *Watcher) deferAll() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	now := time.Now()
+	for _, p := range w.parts {
+		if !p.next.After(now) {
+			p.next = now.Add(p.interval)
+		}
+	}
+}
+
+// record updates the state of the part with the result of a poll and
+// returns the resulting events.
+func (w *Watcher) record(pn string, product *digikey.Product, err error) []Event {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	p, ok := w.parts[pn]
+	if !ok {
+		// The part was unwatched while it was being polled.
+		return nil
+	}
+	now := time.Now()
+	p.next = now.Add(p.interval)
+	if err != nil {
+		return []Event{{Type: EventError, PartNumber: pn, Time: now, Err: err}}
+	}
+	events := p.detect(pn, product, now)
+	events = append(events, p.price.detect(pn, product, p.polled, now)...)
+	p.polled = true
+	p.available = product.QuantityAvailable
+	return events
+}
+
+// detect returns the events caused by the product's new state.
+func (p *part) detect(pn string, product *digikey.Product, now time.Time) []Event {
+	if !p.polled {
+		return nil
+	}
+	prev, cur := p.available, product.QuantityAvailable
+	event := func(t EventType) Event {
+		return Event{Type: t, PartNumber: pn, Product: product, Previous: prev, Current: cur, Time: now}
+	}
+	var events []Event
+	switch {
+	case prev == 0 && cur > 0:
+		events = append(events, event(EventInStock))
+	case prev > 0 && cur == 0:
+		events = append(events, event(EventOutOfStock))
+	case p.threshold > 0 && prev >= p.threshold && cur > 0 && cur < p.threshold:
+		events = append(events, event(EventBelowThreshold))
+	}
+	return events
+}
+
+// emit delivers the events to the handlers, notifiers, and channels.
+func (w *Watcher) emit(ctx context.Context, events []Event) error {
+	for _, e := range events {
+		for _, h := range w.handlers {
+			h(e)
+		}
+		for _, n := range w.notifiers {
+			if err := n.Notify(ctx, e); err != nil && n.onError != nil {
+				n.onError(e, err)
+			}
+		}
+		for _, ch := range w.channels {
+			select {
+			case ch <- e:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+	return nil
+}
+
+// throttled reports whether err shows the quota is exhausted or the API is
+// throttling requests.
+func throttled(err error) bool {
+	var apiErr digikey.Error
+	return errors.Is(err, digikey.ErrQuotaExceeded) ||
+		(errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusTooManyRequests)
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2025 The digikey developers. All rights reserved.
+// Project site: https://github.com/apidepot/digikey
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package watch
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/apidepot/digikey"
+	"github.com/apidepot/digikey/digikeytest"
+)
+
+// newTestWatcher returns a watcher of a fake server and the events it
+// reports.
+func newTestWatcher(t *testing.T, opts ...Option) (*Watcher, *digikeytest.Server, *[]Event) {
+	t.Helper()
+	srv := digikeytest.NewServer("id", "secret")
+	t.Cleanup(srv.Close)
+	c, err := srv.NewClient("id", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var events []Event
+	opts = append([]Option{WithHandler(func(e Event) { events = append(events, e) })}, opts...)
+	return New(c, opts...), srv, &events
+}
+
+// setPart makes the server's catalog a single part with the given stock and
+// unit price.
+func setPart(srv *digikeytest.Server, available int, price float64) {
+	srv.SetProducts(digikey.Product{
+		ManufacturerProductNumber: "PART",
+		QuantityAvailable:         available,
+		UnitPrice:                 digikey.NewMoney(price, "USD"),
+	})
+}
+
+// pollNow polls all watched parts and returns the events reported.
+func pollNow(t *testing.T, w *Watcher, events *[]Event) []Event {
+	t.Helper()
+	w.mu.Lock()
+	for _, p := range w.parts {
+		p.next = time.Time{}
+	}
+	w.mu.Unlock()
+	*events = nil
+	if _, err := w.poll(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	return *events
+}
+
+func TestStockEvents(t *testing.T) {
+	w, srv, events := newTestWatcher(t)
+	w.Watch("PART", Threshold(100))
+	for _, tt := range []struct {
+		available int
+		want      EventType // -1 for no event
+	}{
+		{500, -1}, // the first poll records the baseline
+		{200, -1},
+		{50, EventBelowThreshold},
+		{20, -1},
+		{0, EventOutOfStock},
+		{0, -1},
+		{300, EventInStock},
+		{80, EventBelowThreshold},
+	} {
+		setPart(srv, tt.available, 1)
+		got := pollNow(t, w, events)
+		switch {
+		case tt.want < 0 && len(got) != 0:
+			t.Errorf("stock %d: events %v, want none", tt.available, got)
+		case tt.want >= 0 && (len(got) != 1 || got[0].Type != tt.want || got[0].Current != tt.available):
+			t.Errorf("stock %d: events %v, want %s", tt.available, got, tt.want)
+		}
+	}
+}
+
+func TestPollErrors(t *testing.T) {
+	w, srv, events := newTestWatcher(t)
+	w.Watch("NO-SUCH-PART")
+	if got := pollNow(t, w, events); len(got) != 1 || got[0].Type != EventError || got[0].Err == nil {
+		t.Errorf("poll of an unknown part: events %v, want an error", got)
+	}
+
+	// A throttled poll defers the remaining parts.
+	w.Watch("LM358DR")
+	srv.FailNext(http.StatusTooManyRequests, "slow down")
+	n := len(srv.Requests())
+	got := pollNow(t, w, events)
+	if len(got) != 1 || got[0].Type != EventError || len(srv.Requests()) != n+1 {
+		t.Errorf("throttled poll: events %v after %d requests, want one error after 1", got, len(srv.Requests())-n)
+	}
+	if due := w.due(time.Now()); len(due) != 0 {
+		t.Errorf("parts %v due after a throttled poll, want none", due)
+	}
+}
+
+func TestRun(t *testing.T) {
+	ch := make(chan Event)
+	w, srv, _ := newTestWatcher(t, WithChannel(ch), WithInterval(10*time.Millisecond))
+	setPart(srv, 0, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- w.Run(ctx) }()
+
+	w.Watch("PART")
+	for len(srv.Requests()) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	setPart(srv, 10, 1)
+	select {
+	case e := <-ch:
+		if e.Type != EventInStock || e.PartNumber != "PART" || e.Current != 10 {
+			t.Errorf("Run() reported %v, want PART in stock", e)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run() reported no event")
+	}
+
+	w.Unwatch("PART")
+	cancel()
+	if err := <-done; err != context.Canceled {
+		t.Errorf("Run() = %v, want context.Canceled", err)
+	}
+}